@@ -11,7 +11,7 @@ import (
 // NewCommand returns a new cobra.Command for 'asciidoc' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "asciidoc [PATH]",
 		Aliases:     []string{"adoc"},
 		Short:       "Generate AsciiDoc of inputs and outputs",
@@ -23,13 +23,19 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
+	cmd.PersistentFlags().StringVar(&config.Settings.Placeholder, "placeholder", "n/a", "text rendered in place of missing values (e.g. defaults or descriptions)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultsAsHCL, "defaults-as-hcl", false, "render default values of inputs in HCL syntax instead of JSON (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.HeadingBaseLevel, "heading-base-level", 2, "heading level of AsciiDoc sections [1, 2, 3, 4, 5]")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of AsciiDoc sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringToStringVar(&config.Sections.Titles, "title", map[string]string{}, "title of AsciiDoc sections (e.g. 'inputs=Variables')")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Split, "split-requirements", false, "show Terraform and provider requirements in separate subsections (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.VersionSource, "version-constraint", false, "show file and line each version constraint of requirements is declared at (default false)")
 
 	// deprecation
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoRequired, "no-required", false, "do not show \"Required\" column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoSensitive, "no-sensitive", false, "do not show \"Sensitive\" column or section")
-	cmd.PersistentFlags().MarkDeprecated("no-required", "use '--required=false' instead")   //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-sensitive", "use '--sensitive=false' instead") //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-required", "use '--required=false' instead")   //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-sensitive", "use '--sensitive=false' instead") //nolint:errcheck
 
 	// subcommands
 	cmd.AddCommand(document.NewCommand(config))
@@ -9,7 +9,7 @@ import (
 // NewCommand returns a new cobra.Command for 'asciidoc document' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "document [PATH]",
 		Aliases:     []string{"doc"},
 		Short:       "Generate AsciiDoc document of inputs and outputs",
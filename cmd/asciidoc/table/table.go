@@ -9,7 +9,7 @@ import (
 // NewCommand returns a new cobra.Command for 'asciidoc table' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "table [PATH]",
 		Aliases:     []string{"tbl"},
 		Short:       "Generate AsciiDoc tables of inputs and outputs",
@@ -17,5 +17,9 @@ func NewCommand(config *cli.Config) *cobra.Command {
 		PreRunE:     cli.PreRunEFunc(config),
 		RunE:        cli.RunEFunc(config),
 	}
+
+	// flags
+	cmd.PersistentFlags().IntVar(&config.Settings.TypeMaxLength, "type-max-length", 0, "truncate types of inputs longer than value with an ellipsis, 0 means unlimited")
+
 	return cmd
 }
@@ -0,0 +1,28 @@
+package confluence
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'confluence' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cli.ArgsFunc(config),
+		Use:         "confluence [PATH]",
+		Short:       "Generate Confluence storage format tables of inputs and outputs",
+		Annotations: cli.Annotations("confluence"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().IntVar(&config.Settings.HeadingBaseLevel, "heading-base-level", 2, "heading level of Confluence sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringToStringVar(&config.Sections.Titles, "title", map[string]string{}, "title of Confluence sections (e.g. 'inputs=Variables')")
+	cmd.PersistentFlags().IntVar(&config.Settings.TypeMaxLength, "type-max-length", 0, "truncate types of inputs longer than value with an ellipsis, 0 means unlimited")
+
+	return cmd
+}
@@ -0,0 +1,25 @@
+package csv
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'csv' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cli.ArgsFunc(config),
+		Use:         "csv [PATH]",
+		Short:       "Generate CSV of inputs and outputs",
+		Annotations: cli.Annotations("csv"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+
+	return cmd
+}
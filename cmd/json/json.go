@@ -3,13 +3,14 @@ package json
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/segmentio/terraform-docs/cmd/json/schema"
 	"github.com/segmentio/terraform-docs/internal/cli"
 )
 
 // NewCommand returns a new cobra.Command for 'json' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "json [PATH]",
 		Short:       "Generate JSON of inputs and outputs",
 		Annotations: cli.Annotations("json"),
@@ -18,11 +19,19 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	}
 
 	// flags
-	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
+	cmd.PersistentFlags().StringVar(&config.Settings.EscapeMode, "escape-mode", "markdown", "escape mode of special characters [all, markdown, none]")
+	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "number of spaces to indent JSON with")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Compact, "compact", false, "emit minified JSON without indentation and newlines (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.PartitionSensitive, "partition-sensitive-outputs", false, "group outputs into 'sensitive' and 'public' lists (default false)")
 
 	// deprecation
+	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoEscape, "no-escape", false, "do not escape special characters")
-	cmd.PersistentFlags().MarkDeprecated("no-escape", "use '--escape=false' instead") //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "escape", "use '--escape-mode' instead")         //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-escape", "use '--escape-mode none' instead") //nolint:errcheck
+
+	// subcommands
+	cmd.AddCommand(schema.NewCommand(config))
 
 	return cmd
 }
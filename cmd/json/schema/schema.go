@@ -0,0 +1,20 @@
+package schema
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'json schema' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cli.ArgsFunc(config),
+		Use:         "schema [PATH]",
+		Short:       "Generate JSON Schema of the document generated by 'json'",
+		Annotations: cli.Annotations("json schema"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+	return cmd
+}
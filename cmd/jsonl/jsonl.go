@@ -0,0 +1,25 @@
+package jsonl
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'jsonl' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cli.ArgsFunc(config),
+		Use:         "jsonl [PATH]",
+		Short:       "Generate JSON Lines of inputs and outputs, one module per line",
+		Annotations: cli.Annotations("jsonl"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().StringVar(&config.Settings.EscapeMode, "escape-mode", "markdown", "escape mode of special characters [all, markdown, none]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.PartitionSensitive, "partition-sensitive-outputs", false, "group outputs into 'sensitive' and 'public' lists (default false)")
+
+	return cmd
+}
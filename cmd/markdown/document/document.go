@@ -9,7 +9,7 @@ import (
 // NewCommand returns a new cobra.Command for 'markdown document' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "document [PATH]",
 		Aliases:     []string{"doc"},
 		Short:       "Generate Markdown document of inputs and outputs",
@@ -17,5 +17,20 @@ func NewCommand(config *cli.Config) *cobra.Command {
 		PreRunE:     cli.PreRunEFunc(config),
 		RunE:        cli.RunEFunc(config),
 	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Collapse, "collapse-descriptions", false, "collapse descriptions of inputs longer than '--collapse-threshold'")
+	cmd.PersistentFlags().IntVar(&config.Settings.CollapseLength, "collapse-threshold", 200, "length of descriptions above which they get collapsed")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ExtractExamples, "extract-examples", false, "render '@example ... @end' blocks of descriptions of inputs as code blocks")
+	cmd.PersistentFlags().BoolVar(&config.Settings.FormatTypes, "format-complex-types", false, "render complex types of inputs as formatted code blocks")
+	cmd.PersistentFlags().BoolVar(&config.Settings.GroupByFile, "group-by-file", false, "group inputs and outputs under subheadings of the file they're declared in")
+	cmd.PersistentFlags().BoolVar(&config.Settings.HideEmpty, "hide-empty", false, "omit subsections without any item (e.g. optional inputs) instead of stating they're empty")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputsAsSubsections, "inputs-as-subsections", false, "render inputs as subsections one level deeper, with a stable anchor to link to")
+	cmd.PersistentFlags().BoolVar(&config.Settings.RawDescriptions, "raw-descriptions", false, "render multi-line Markdown of descriptions as written instead of escaping it")
+	cmd.PersistentFlags().BoolVar(&config.Settings.SensitiveAlerts, "sensitive-alerts", false, "show sensitive inputs with GitHub warning alert, requires '--sensitive'")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowTOC, "show-toc", false, "show table of contents linking to the sections")
+	cmd.PersistentFlags().BoolVar(&config.Settings.SplitRequiredOptional, "split-required-optional", false, "render required and optional inputs in separate subsections of the inputs section")
+	cmd.PersistentFlags().IntVar(&config.Settings.MaxLineLength, "max-line-length", 0, "wrap descriptions longer than value, 0 means unlimited")
+
 	return cmd
 }
@@ -11,7 +11,7 @@ import (
 // NewCommand returns a new cobra.Command for 'markdown' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "markdown [PATH]",
 		Aliases:     []string{"md"},
 		Short:       "Generate Markdown of inputs and outputs",
@@ -21,18 +21,36 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	}
 
 	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Anchor, "anchor", false, "create anchor links of providers and link requirements to them")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
-	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
+	cmd.PersistentFlags().StringVar(&config.Settings.AnchorStyle, "anchor-style", "github", "style of heading anchors the table of contents links to [github, gitlab]")
+	cmd.PersistentFlags().StringVar(&config.Settings.BadgeStyle, "badge-style", "text", "style of Required and Sensitive indicators [text, emoji, shield]")
+	cmd.PersistentFlags().StringVar(&config.Settings.BooleanStyle, "boolean-style", "yes-no", "rendering of Required and Sensitive indicators with 'text' badge style [yes-no, true-false, check-cross]")
+	cmd.PersistentFlags().StringVar(&config.Settings.SensitiveMark, "sensitive-mark", "yes", "text or emoji marking sensitive items with 'text' badge style (e.g. '🔒')")
+	cmd.PersistentFlags().StringVar(&config.Settings.Placeholder, "placeholder", "n/a", "text rendered in place of missing values (e.g. defaults or descriptions)")
+	cmd.PersistentFlags().StringVar(&config.Settings.EscapeMode, "escape-mode", "markdown", "escape mode of special characters [all, markdown, none]")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultsAsHCL, "defaults-as-hcl", false, "render default values of inputs in HCL syntax instead of JSON (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.HeadingBaseLevel, "heading-base-level", 2, "heading level of Markdown sections [1, 2, 3, 4, 5]")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of Markdown sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().BoolVar(&config.Catalog, "catalog", false, "render all the modules found in PATH into one document, each under a heading linking to its directory (default false)")
+	cmd.PersistentFlags().StringVar(&config.Diff, "diff", "", "path of another version of the module to render the changes of inputs and outputs of PATH compared to it, instead of its document")
+	cmd.PersistentFlags().StringToStringVar(&config.Sections.Titles, "title", map[string]string{}, "title of Markdown sections (e.g. 'inputs=Variables')")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ShowSummary, "show-summary", false, "show counts of inputs, outputs, providers, etc. after the header (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.LinkResources, "link-resources", false, "link resources and data sources to their documentation on Terraform Registry (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Split, "split-requirements", false, "show Terraform and provider requirements in separate subsections (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TrimDescription, "trim-description", false, "show only the first sentence of descriptions of inputs and outputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.VersionSource, "version-constraint", false, "show file and line each version constraint of requirements is declared at (default false)")
 
 	// deprecation
+	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoRequired, "no-required", false, "do not show \"Required\" column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoSensitive, "no-sensitive", false, "do not show \"Sensitive\" column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoEscape, "no-escape", false, "do not escape special characters")
-	cmd.PersistentFlags().MarkDeprecated("no-required", "use '--required=false' instead")   //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-sensitive", "use '--sensitive=false' instead") //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-escape", "use '--escape=false' instead")       //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-required", "use '--required=false' instead")   //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-sensitive", "use '--sensitive=false' instead") //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "escape", "use '--escape-mode' instead")           //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-escape", "use '--escape-mode none' instead")   //nolint:errcheck
 
 	// subcommands
 	cmd.AddCommand(document.NewCommand(config))
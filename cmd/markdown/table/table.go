@@ -9,7 +9,7 @@ import (
 // NewCommand returns a new cobra.Command for 'markdown table' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "table [PATH]",
 		Aliases:     []string{"tbl"},
 		Short:       "Generate Markdown tables of inputs and outputs",
@@ -17,5 +17,12 @@ func NewCommand(config *cli.Config) *cobra.Command {
 		PreRunE:     cli.PreRunEFunc(config),
 		RunE:        cli.RunEFunc(config),
 	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.NoTypeColumn, "no-type-column", false, "do not show Type column of inputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.NoDefaultColumn, "no-default-column", false, "do not show Default column of inputs (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.TypeMaxLength, "type-max-length", 0, "truncate types of inputs longer than value with an ellipsis, 0 means unlimited")
+	cmd.PersistentFlags().IntVar(&config.Settings.WrapAt, "wrap-at", 0, "wrap descriptions and default values in cells longer than value with '<br>', 0 means unlimited")
+
 	return cmd
 }
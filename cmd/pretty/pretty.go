@@ -9,7 +9,7 @@ import (
 // NewCommand returns a new cobra.Command for pretty formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "pretty [PATH]",
 		Short:       "Generate colorized pretty of inputs and outputs",
 		Annotations: cli.Annotations("pretty"),
@@ -22,7 +22,7 @@ func NewCommand(config *cli.Config) *cobra.Command {
 
 	// deprecation
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoColor, "no-color", false, "do not colorize printed result")
-	cmd.PersistentFlags().MarkDeprecated("no-color", "use '--color=false' instead") //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-color", "use '--color=false' instead") //nolint:errcheck
 
 	return cmd
 }
@@ -2,14 +2,20 @@ package cmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 
 	"github.com/segmentio/terraform-docs/cmd/asciidoc"
 	"github.com/segmentio/terraform-docs/cmd/completion"
+	"github.com/segmentio/terraform-docs/cmd/confluence"
+	"github.com/segmentio/terraform-docs/cmd/csv"
 	"github.com/segmentio/terraform-docs/cmd/json"
+	"github.com/segmentio/terraform-docs/cmd/jsonl"
 	"github.com/segmentio/terraform-docs/cmd/markdown"
 	"github.com/segmentio/terraform-docs/cmd/pretty"
+	"github.com/segmentio/terraform-docs/cmd/rst"
+	"github.com/segmentio/terraform-docs/cmd/template"
 	"github.com/segmentio/terraform-docs/cmd/tfvars"
 	"github.com/segmentio/terraform-docs/cmd/toml"
 	"github.com/segmentio/terraform-docs/cmd/version"
@@ -21,8 +27,10 @@ import (
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() error {
-	if err := NewCommand().Execute(); err != nil {
-		fmt.Printf("Error: %s\n", err.Error())
+	cmd := NewCommand()
+	cmd.SetArgs(cli.FormatterArgs(cmd, os.Args[1:]))
+	if err := cmd.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err.Error())
 		return err
 	}
 	return nil
@@ -32,7 +40,6 @@ func Execute() error {
 func NewCommand() *cobra.Command {
 	config := cli.DefaultConfig()
 	cmd := &cobra.Command{
-		Args:          cobra.NoArgs,
 		Use:           "terraform-docs",
 		Short:         "A utility to generate documentation from Terraform modules in various output formats",
 		Long:          "A utility to generate documentation from Terraform modules in various output formats",
@@ -42,40 +49,98 @@ func NewCommand() *cobra.Command {
 	}
 
 	// flags
-	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section [header, inputs, outputs, providers, requirements]")
-	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section [header, inputs, outputs, providers, requirements]")
+	cmd.PersistentFlags().StringVar(&config.File, "config", ".terraform-docs.yml", "relative path of the config file to read options from")
+	cmd.PersistentFlags().StringVar(&config.Source, "source", "", "remote Git source to read the module from instead of PATH (e.g. 'git::https://example.com/modules.git//network?ref=v1.0.0')")
+
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section [checks, data-sources, footer, header, imports, inputs, meta, modules, moved, outputs, providers, requirements, resources, usage]")
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section [checks, data-sources, footer, header, imports, inputs, meta, modules, moved, outputs, providers, requirements, resources, usage]")
 	cmd.PersistentFlags().BoolVar(&config.Sections.ShowAll, "show-all", true, "show all sections")
 	cmd.PersistentFlags().BoolVar(&config.Sections.HideAll, "hide-all", false, "hide all sections (default false)")
+	cmd.PersistentFlags().StringVar(&config.Sections.Only, "only", "", "show only one section, without its heading, e.g. for piping [checks, data-sources, footer, header, imports, inputs, meta, modules, moved, outputs, providers, requirements, resources, usage]")
+	cmd.PersistentFlags().StringVar(&config.ReadmeTemplate, "readme-template", "", "relative path of the README template in the module, whose placeholders (e.g. '{{ .Inputs }}') are filled with the sections")
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Order, "sections-order", []string{}, "order of sections, the ones omitted follow in their default order (e.g. 'requirements,inputs')")
 
 	cmd.PersistentFlags().BoolVar(&config.Sort.Enabled, "sort", true, "sort items")
-	cmd.PersistentFlags().BoolVar(&config.Sort.By.Required, "sort-by-required", false, "sort items by name and print required ones first (default false)")
-	cmd.PersistentFlags().BoolVar(&config.Sort.By.Type, "sort-by-type", false, "sort items by type of them (default false)")
+	cmd.PersistentFlags().StringVar((*string)(&config.Sort.By), "sort-by", "name", "sort items by criteria [name, required, type, declaration, position], or a comma-separated list of them compared in turn (e.g. 'type,required')")
+	cmd.PersistentFlags().StringVar((*string)(&config.Sort.InputsBy), "sort-inputs-by", "", "sort inputs by criteria [name, required, type, declaration, position], or a comma-separated list of them (default same as other items)")
+	cmd.PersistentFlags().StringVar((*string)(&config.Sort.OutputsBy), "sort-outputs-by", "", "sort outputs by criteria [name, required, type, declaration, position], or a comma-separated list of them (default same as other items)")
+	cmd.PersistentFlags().BoolVar(&config.Sort.ByPosition, "sort-by-position", false, "sort items by the file and line they are declared at, same as '--sort-by position' (default false)")
+
+	cmd.PersistentFlags().StringSliceVar((*[]string)(&config.HeaderFrom), "header-from", []string{"main.tf"}, "relative path of a file to read header from, repeat to concatenate multiple files in order")
+	cmd.PersistentFlags().StringVar(&config.FooterFrom, "footer-from", "", "relative path of a file to read footer from (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.IncludeExamples, "include-examples", "", "relative path of an example file to show as usage of the module (e.g. 'examples/basic/main.tf') (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.DefaultValues, "default-values-file", "", "path of a '.tfvars' or '.tfvars.json' file to override default values of inputs with (default \"\")")
+
+	cmd.PersistentFlags().StringSliceVar(&config.Filter.IncludeInputs, "include-inputs", []string{}, "glob pattern of inputs to document, all if not set (e.g. 'aws_*')")
+	cmd.PersistentFlags().StringSliceVar(&config.Filter.ExcludeInputs, "exclude-inputs", []string{}, "glob pattern of inputs not to document (e.g. 'internal_*')")
+	cmd.PersistentFlags().StringSliceVar(&config.Filter.IncludeOutputs, "include-outputs", []string{}, "glob pattern of outputs to document, all if not set (e.g. 'aws_*')")
+	cmd.PersistentFlags().StringSliceVar(&config.Filter.ExcludeOutputs, "exclude-outputs", []string{}, "glob pattern of outputs not to document (e.g. 'internal_*')")
+
+	cmd.PersistentFlags().StringVar(&config.Output.File, "output-file", "", "relative path of a file to write the output into (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.Output.Mode, "output-mode", "inject", "mode of writing into the output file [inject, replace]")
+	cmd.PersistentFlags().StringVar(&config.PostProcess, "post-process", "", "shell command to pipe the output through before it's written or printed, e.g. to format it (default \"\")")
+	cmd.PersistentFlags().BoolVar(&config.Output.Check, "check", false, "check if the output file is up to date without writing into it, requires '--output-file' (default false)")
+	cmd.PersistentFlags().Var(&config.Targets, "target", "additional output to render the module into, in the form of 'formatter=file' and repeatable (e.g. 'json=docs.json')")
+
+	cmd.PersistentFlags().BoolVar(&config.FailOnMissingDescription, "fail-on-missing-description", false, "only check all inputs and outputs have description, and fail listing the ones which don't (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Quiet, "quiet", false, "suppress deprecation notices and informational messages, only print the output and errors (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Strict, "strict", false, "fail on warnings of parsing the module (e.g. duplicate names of inputs), which are only printed out to stderr and don't fail by default (default false)")
+
+	cmd.PersistentFlags().BoolVar(&config.Recursive.Enabled, "recursive", false, "generate docs for submodules as well, requires '--output-file' (default false)")
+	cmd.PersistentFlags().StringVar(&config.Recursive.Path, "recursive-path", "modules", "relative path of the directory to look for submodules in")
+	cmd.PersistentFlags().IntVar(&config.Parallelism, "parallelism", 1, "number of modules to load and render concurrently with '--recursive' or '--catalog'")
 
-	cmd.PersistentFlags().StringVar(&config.HeaderFrom, "header-from", "main.tf", "relative path of a file to read header from")
+	cmd.PersistentFlags().BoolVar(&config.Settings.MetaTimestamp, "meta-timestamp", true, "include the time of generation in 'meta' section, disable it for deterministic '--check'")
+	cmd.PersistentFlags().BoolVar(&config.Settings.NoEmptyDefaults, "no-empty-defaults", false, "mark inputs without default value explicitly as 'n/a' or 'required' instead of leaving it empty (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.NormalizeModuleSources, "normalize-module-sources", false, "show local sources of modules relative to the root of the repository (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.NormalizeTypes, "normalize-types", false, "show types of inputs in a canonical form, regardless of their spacing and quoting (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Nullable, "nullable", false, "show whether inputs accept 'null' as their value (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Validation, "validation", false, "show 'validation' rules of inputs (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DependsOn, "depends-on", false, "show 'depends_on' of module calls (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ReadComments, "read-comments", true, "use comments preceding inputs and outputs as their description when 'description' isn't set")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Lockfile, "lockfile", false, "read locked versions of providers from '.terraform.lock.hcl' (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.TerraformRequirement, "terraform-requirement", true, "include the version constraint of Terraform core in requirements, disable it to list providers only")
+	cmd.PersistentFlags().BoolVar(&config.Settings.ProviderNamespace, "provider-namespace", false, "show namespaced source of providers (e.g. 'hashicorp/aws') declared in 'required_providers' (default false)")
 
 	cmd.PersistentFlags().BoolVar(&config.OutputValues.Enabled, "output-values", false, "inject output values into outputs (default false)")
-	cmd.PersistentFlags().StringVar(&config.OutputValues.From, "output-values-from", "", "inject output values from file into outputs (default \"\")")
+	cmd.PersistentFlags().StringSliceVar((*[]string)(&config.OutputValues.From), "output-values-from", []string{}, "inject output values from file into outputs, repeat to merge multiple files with the later ones taking precedence")
+	cmd.PersistentFlags().BoolVar(&config.Settings.InputValues, "input-values", false, "inject output values into inputs of the same name, requires '--output-values' (default false)")
 
 	// deprecation
+	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoFooter, "no-footer", false, "do not show module footer")
 	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoHeader, "no-header", false, "do not show module header")
 	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoInputs, "no-inputs", false, "do not show inputs")
+	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoModules, "no-modules", false, "do not show modules")
 	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoOutputs, "no-outputs", false, "do not show outputs")
 	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoProviders, "no-providers", false, "do not show providers")
 	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoRequirements, "no-requirements", false, "do not show module requirements")
+	cmd.PersistentFlags().BoolVar(&config.Sections.Deprecated.NoResources, "no-resources", false, "do not show resources")
 	cmd.PersistentFlags().BoolVar(&config.Sort.Deprecated.NoSort, "no-sort", false, "do no sort items")
+	cmd.PersistentFlags().BoolVar(&config.Sort.Deprecated.ByRequired, "sort-by-required", false, "sort items by name and print required ones first (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Sort.Deprecated.ByType, "sort-by-type", false, "sort items by type of them (default false)")
 
-	cmd.PersistentFlags().MarkDeprecated("no-header", "use '--hide header' instead")             //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-inputs", "use '--hide inputs' instead")             //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-outputs", "use '--hide outputs' instead")           //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-providers", "use '--hide providers' instead")       //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-requirements", "use '--hide requirements' instead") //nolint:errcheck
-	cmd.PersistentFlags().MarkDeprecated("no-sort", "use '--sort=false' instead")                //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-footer", "use '--hide footer' instead")             //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-header", "use '--hide header' instead")             //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-inputs", "use '--hide inputs' instead")             //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-modules", "use '--hide modules' instead")           //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-outputs", "use '--hide outputs' instead")           //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-providers", "use '--hide providers' instead")       //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-requirements", "use '--hide requirements' instead") //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-resources", "use '--hide resources' instead")       //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "no-sort", "use '--sort=false' instead")                //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "sort-by-required", "use '--sort-by required' instead") //nolint:errcheck
+	cli.MarkDeprecated(cmd.PersistentFlags(), "sort-by-type", "use '--sort-by type' instead")         //nolint:errcheck
 
 	// formatter subcommands
 	cmd.AddCommand(asciidoc.NewCommand(config))
+	cmd.AddCommand(confluence.NewCommand(config))
+	cmd.AddCommand(csv.NewCommand(config))
 	cmd.AddCommand(json.NewCommand(config))
+	cmd.AddCommand(jsonl.NewCommand(config))
 	cmd.AddCommand(markdown.NewCommand(config))
 	cmd.AddCommand(pretty.NewCommand(config))
+	cmd.AddCommand(rst.NewCommand(config))
+	cmd.AddCommand(template.NewCommand(config))
 	cmd.AddCommand(tfvars.NewCommand(config))
 	cmd.AddCommand(toml.NewCommand(config))
 	cmd.AddCommand(xml.NewCommand(config))
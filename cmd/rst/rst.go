@@ -0,0 +1,33 @@
+package rst
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'rst' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cli.ArgsFunc(config),
+		Use:         "rst [PATH]",
+		Short:       "Generate reStructuredText tables of inputs and outputs",
+		Annotations: cli.Annotations("rst"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().StringVar(&config.Settings.EscapeMode, "escape-mode", "markdown", "escape mode of special characters [all, markdown, none]")
+	cmd.PersistentFlags().IntVar(&config.Settings.HeadingBaseLevel, "heading-base-level", 2, "heading level of reStructuredText sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringToStringVar(&config.Sections.Titles, "title", map[string]string{}, "title of reStructuredText sections (e.g. 'inputs=Variables')")
+	cmd.PersistentFlags().IntVar(&config.Settings.TypeMaxLength, "type-max-length", 0, "truncate types of inputs longer than value with an ellipsis, 0 means unlimited")
+
+	// deprecation
+	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
+	cli.MarkDeprecated(cmd.PersistentFlags(), "escape", "use '--escape-mode' instead") //nolint:errcheck
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package template
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'template' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cli.ArgsFunc(config),
+		Use:         "template [PATH]",
+		Short:       "Generate output of inputs and outputs with a custom Go template",
+		Annotations: cli.Annotations("template"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().StringVar(&config.OutputTemplate, "output-template", "", "file path of Go template to render the module with")
+
+	return cmd
+}
@@ -9,7 +9,7 @@ import (
 // NewCommand returns a new cobra.Command for 'tfvars json' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "json [PATH]",
 		Short:       "Generate JSON format of terraform.tfvars of inputs",
 		Annotations: cli.Annotations("tfvars json"),
@@ -11,7 +11,7 @@ import (
 // NewCommand returns a new cobra.Command for 'tfvars' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "tfvars [PATH]",
 		Short:       "Generate terraform.tfvars of inputs",
 		Annotations: cli.Annotations("tfvars"),
@@ -9,12 +9,16 @@ import (
 // NewCommand returns a new cobra.Command for 'xml' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "xml [PATH]",
 		Short:       "Generate XML of inputs and outputs",
 		Annotations: cli.Annotations("xml"),
 		PreRunE:     cli.PreRunEFunc(config),
 		RunE:        cli.RunEFunc(config),
 	}
+
+	// flags
+	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "number of spaces to indent XML with")
+
 	return cmd
 }
@@ -9,12 +9,16 @@ import (
 // NewCommand returns a new cobra.Command for 'yaml' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
+		Args:        cli.ArgsFunc(config),
 		Use:         "yaml [PATH]",
 		Short:       "Generate YAML of inputs and outputs",
 		Annotations: cli.Annotations("yaml"),
 		PreRunE:     cli.PreRunEFunc(config),
 		RunE:        cli.RunEFunc(config),
 	}
+
+	// flags
+	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "number of spaces to indent YAML with")
+
 	return cmd
 }
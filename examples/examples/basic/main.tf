@@ -0,0 +1,10 @@
+module "foo" {
+  source = "../../"
+
+  input_with_underscores = "foo"
+  string-1               = "bar"
+
+  map-2 = {
+    a = 1
+  }
+}
@@ -57,3 +57,46 @@ data "aws_caller_identity" "ident" {
 }
 
 resource "null_resource" "foo" {}
+
+module "foo" {
+  source  = "bar"
+  version = "1.2.3"
+}
+
+module "baz" {
+  source = "./modules/baz"
+
+  depends_on = [module.foo]
+}
+
+moved {
+  from = null_resource.bar
+  to   = null_resource.foo
+}
+
+moved {
+  from = module.bar
+  to   = module.baz
+}
+
+check "health" {
+  assert {
+    condition     = var.input-with-code-block != null
+    error_message = "The input-with-code-block must be set."
+  }
+
+  assert {
+    condition     = length(var.list-3) > 0
+    error_message = "The list-3 must not be empty."
+  }
+}
+
+import {
+  to = null_resource.foo
+  id = "foo_id"
+}
+
+import {
+  to = tls_private_key.baz
+  id = "${var.input_with_underscores}-key"
+}
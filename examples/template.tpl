@@ -0,0 +1,12 @@
+{{ with .Module.Header }}{{ . }}
+
+{{ end -}}
+## Inputs
+{{ range .Module.Inputs }}
+- `{{ .Name }}` ({{ tostring .Type | default "any" }}){{ if .Required }} **required**{{ end }}
+{{- end }}
+
+## Outputs
+{{ range .Module.Outputs }}
+- `{{ .Name }}`{{ with tostring .Description }}: {{ . }}{{ end }}
+{{- end }}
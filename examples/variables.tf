@@ -162,6 +162,16 @@ variable "string_no_default" {
 variable "number_default_zero" {
   type    = number
   default = 0
+
+  validation {
+    condition     = var.number_default_zero >= 0 || var.number_default_zero == -1
+    error_message = "The number must not be negative, or -1 for unlimited."
+  }
+
+  validation {
+    condition     = floor(var.number_default_zero) == var.number_default_zero
+    error_message = "The number must be an integer, got ${var.number_default_zero}."
+  }
 }
 
 variable "bool_default_false" {
@@ -175,6 +185,7 @@ variable "list_default_empty" {
 }
 
 variable "object_default_empty" {
-  type    = object({})
-  default = {}
+  type     = object({})
+  default  = {}
+  nullable = false
 }
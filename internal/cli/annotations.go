@@ -1,9 +1,16 @@
 package cli
 
 import (
+	"fmt"
 	"strings"
+
+	"github.com/spf13/pflag"
 )
 
+// deprecatedAnnotation is the annotation of flags holding the message to
+// show when they're used
+const deprecatedAnnotation = "terraform-docs_deprecated"
+
 // Annotations returns set of annotations for cobra.Commands,
 // specifically the 'command' namd and command 'kind'
 func Annotations(cmd string) map[string]string {
@@ -14,3 +21,25 @@ func Annotations(cmd string) map[string]string {
 	annotations["kind"] = "formatter"
 	return annotations
 }
+
+// MarkDeprecated hides flag 'name' of 'flags' from help and annotates it
+// with 'message', which is shown when the flag is used unless '--quiet' is
+// set. Unlike pflag.MarkDeprecated, the notice is written into stderr when
+// the Config gets normalized instead of while flags are being parsed.
+func MarkDeprecated(flags *pflag.FlagSet, name string, message string) error {
+	flag := flags.Lookup(name)
+	if flag == nil {
+		return fmt.Errorf("flag %q does not exist", name)
+	}
+	flag.Hidden = true
+	return flags.SetAnnotation(name, deprecatedAnnotation, []string{message})
+}
+
+// deprecation returns the deprecation message of 'flag', if any
+func deprecation(flag *pflag.Flag) (string, bool) {
+	message, ok := flag.Annotations[deprecatedAnnotation]
+	if !ok || len(message) == 0 {
+		return "", false
+	}
+	return message[0], true
+}
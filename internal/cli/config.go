@@ -2,34 +2,158 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
 	"strings"
+	"sync"
 
+	"gopkg.in/yaml.v3"
+
+	"github.com/segmentio/terraform-docs/internal/format"
 	"github.com/segmentio/terraform-docs/internal/module"
 	"github.com/segmentio/terraform-docs/pkg/print"
 )
 
-// list of flagset items which explicitly changed from CLI
-var changedfs = make(map[string]bool)
+// flagset is the set of flagset items which explicitly changed from CLI (or
+// config file or environment variables) during one run, along with the
+// deprecated ones among them and the ones read from the config file of a
+// remote '--source'. It's safe to be read and updated by modules processed
+// concurrently, and a nil flagset has no item changed.
+type flagset struct {
+	sync.RWMutex
+	items      map[string]bool
+	env        map[string]bool
+	fetched    map[string]bool
+	deprecated [][2]string
+}
+
+func newFlagset() *flagset {
+	return &flagset{
+		items:      make(map[string]bool),
+		env:        make(map[string]bool),
+		fetched:    make(map[string]bool),
+		deprecated: [][2]string{},
+	}
+}
+
+// changed indicates if flagset item 'name' explicitly changed
+func (f *flagset) changed(name string) bool {
+	if f == nil {
+		return false
+	}
+	f.RLock()
+	defer f.RUnlock()
+	return f.items[name] || f.env[name]
+}
+
+// set marks flagset item 'name' as explicitly 'changed' or not, an item
+// which is no longer changed isn't considered as read from environment
+// variables either
+func (f *flagset) set(name string, changed bool) {
+	f.Lock()
+	defer f.Unlock()
+	f.items[name] = changed
+	if !changed {
+		delete(f.env, name)
+	}
+}
+
+// setFromEnv marks flagset item 'name' as read from its environment variable,
+// which is explicitly changed but can still be overridden by config file
+func (f *flagset) setFromEnv(name string) {
+	f.Lock()
+	defer f.Unlock()
+	f.env[name] = true
+}
+
+// fromEnv indicates if flagset item 'name' is read from its environment
+// variable, rather than set from CLI or config file
+func (f *flagset) fromEnv(name string) bool {
+	if f == nil {
+		return false
+	}
+	f.RLock()
+	defer f.RUnlock()
+	return f.env[name] && !f.items[name]
+}
+
+// setFromSource marks flagset item 'name' as read from the config file of a
+// remote '--source', which can't be trusted
+func (f *flagset) setFromSource(name string) {
+	f.Lock()
+	defer f.Unlock()
+	f.fetched[name] = true
+}
+
+// fromSource indicates if flagset item 'name' is read from the config file
+// of a remote '--source', rather than set from CLI or a local config file
+func (f *flagset) fromSource(name string) bool {
+	if f == nil {
+		return false
+	}
+	f.RLock()
+	defer f.RUnlock()
+	return f.fetched[name]
+}
+
+// deprecate records deprecated flagset item 'name', which explicitly changed,
+// along with its deprecation 'message'
+func (f *flagset) deprecate(name string, message string) {
+	f.Lock()
+	defer f.Unlock()
+	f.deprecated = append(f.deprecated, [2]string{name, message})
+}
+
+// deprecations returns the deprecated flagset items which explicitly changed,
+// in lexicographical order, and their deprecation message
+func (f *flagset) deprecations() [][2]string {
+	if f == nil {
+		return nil
+	}
+	f.RLock()
+	defer f.RUnlock()
+	return f.deprecated
+}
 
 type _sections struct {
+	NoFooter       bool
 	NoHeader       bool
 	NoInputs       bool
+	NoModules      bool
 	NoOutputs      bool
 	NoProviders    bool
 	NoRequirements bool
+	NoResources    bool
 }
+
+// list of all the sections which can be shown, hidden or titled
+var sectionNames = []string{"checks", "data-sources", "footer", "header", "imports", "inputs", "meta", "modules", "moved", "outputs", "providers", "requirements", "resources", "usage"}
+
 type sections struct {
-	Show       []string
-	Hide       []string
-	ShowAll    bool
-	HideAll    bool
-	Deprecated *_sections
+	Show       []string          `yaml:"show"`
+	Hide       []string          `yaml:"hide"`
+	ShowAll    bool              `yaml:"show-all"`
+	HideAll    bool              `yaml:"hide-all"`
+	Only       string            `yaml:"only"`
+	Order      []string          `yaml:"order"`
+	Titles     map[string]string `yaml:"titles"`
+	Deprecated *_sections        `yaml:"-"`
 
+	checks       bool
+	dataSources  bool
+	footer       bool
 	header       bool
+	imports      bool
 	inputs       bool
+	meta         bool
+	modules      bool
+	moved        bool
 	outputs      bool
 	providers    bool
 	requirements bool
+	resources    bool
+	usage        bool
 }
 
 func defaultSections() *sections {
@@ -38,92 +162,118 @@ func defaultSections() *sections {
 		Hide:    []string{},
 		ShowAll: true,
 		HideAll: false,
+		Only:    "",
+		Order:   []string{},
+		Titles:  map[string]string{},
 		Deprecated: &_sections{
+			NoFooter:       false,
 			NoHeader:       false,
 			NoInputs:       false,
+			NoModules:      false,
 			NoOutputs:      false,
 			NoProviders:    false,
 			NoRequirements: false,
+			NoResources:    false,
 		},
 
+		checks:       false,
+		dataSources:  false,
+		footer:       false,
 		header:       false,
+		imports:      false,
 		inputs:       false,
+		meta:         false,
+		modules:      false,
+		moved:        false,
 		outputs:      false,
 		providers:    false,
 		requirements: false,
+		resources:    false,
+		usage:        false,
 	}
 }
 
-func (s *sections) validate() error {
-	items := []string{"header", "inputs", "outputs", "providers", "requirements"}
+func (s *sections) validate(flags *flagset) error {
+	items := sectionNames
 	for _, item := range s.Show {
-		switch item {
-		case items[0], items[1], items[2], items[3], items[4]:
-		default:
+		if !contains(items, item) {
 			return fmt.Errorf("'%s' is not a valid section", item)
 		}
 	}
 	for _, item := range s.Hide {
-		switch item {
-		case items[0], items[1], items[2], items[3], items[4]:
-		default:
+		if !contains(items, item) {
 			return fmt.Errorf("'%s' is not a valid section", item)
 		}
 	}
+	if s.Only != "" {
+		if !contains(items, s.Only) {
+			return fmt.Errorf("'%s' is not a valid section of '--only'", s.Only)
+		}
+		if flags.changed("show") || flags.changed("hide") || flags.changed("show-all") || flags.changed("hide-all") {
+			return fmt.Errorf("'--only' can't be used with '--show', '--hide', '--show-all' or '--hide-all'")
+		}
+	}
+	// meta is always a line at the end of the output, it's neither ordered
+	// nor titled as the other sections
+	for i, item := range s.Order {
+		if !contains(items, item) || item == "meta" {
+			return fmt.Errorf("'%s' is not a valid section of '--sections-order'", item)
+		}
+		if contains(s.Order[:i], item) {
+			return fmt.Errorf("'%s' is repeated in '--sections-order'", item)
+		}
+	}
+	for item := range s.Titles {
+		if !contains(items, item) || item == "meta" {
+			return fmt.Errorf("'%s' is not a valid section of '--title'", item)
+		}
+	}
 	if s.ShowAll && s.HideAll {
 		return fmt.Errorf("'--show-all' and '--hide-all' can't be used together")
 	}
-	if s.ShowAll && len(s.Show) != 0 {
-		return fmt.Errorf("'--show-all' and '--show' can't be used together")
-	}
-	if s.HideAll && len(s.Hide) != 0 {
-		return fmt.Errorf("'--hide-all' and '--hide' can't be used together")
+	for _, item := range s.Show {
+		if contains(s.Hide, item) {
+			return fmt.Errorf("'%s' can't be used with both '--show' and '--hide'", item)
+		}
 	}
 	for _, section := range items {
-		if changedfs["no-"+section] && contains(s.Hide, section) {
+		if flags.changed("no-"+section) && contains(s.Hide, section) {
 			return fmt.Errorf("'--no-%s' and '--hide %s' can't be used together", section, section)
 		}
 	}
 	return nil
 }
 
+// visibility of 'section', either shown with '--show-all' or hidden with
+// '--hide-all' as the base, unless it's an exception of them listed with
+// '--hide' or '--show' respectively
 func (s *sections) visibility(section string) bool {
-	if s.ShowAll && !s.HideAll {
-		for _, n := range s.Hide {
-			if n == section {
-				return false
-			}
-		}
-		return true
-	}
-	for _, n := range s.Show {
-		if n == section {
-			return true
-		}
-	}
-	for _, n := range s.Hide {
-		if n == section {
-			return false
-		}
+	if s.HideAll {
+		return contains(s.Show, section)
 	}
-	return false
+	return !contains(s.Hide, section)
 }
 
 type outputvalues struct {
-	Enabled bool
-	From    string
+	Enabled bool     `yaml:"enabled"`
+	From    pathlist `yaml:"from"`
 }
 
 func defaultOutputValues() *outputvalues {
 	return &outputvalues{
 		Enabled: false,
-		From:    "",
+		From:    pathlist{},
 	}
 }
 
-func (o *outputvalues) validate() error {
-	if o.Enabled && o.From == "" {
-		if changedfs["output-values-from"] {
+func (o *outputvalues) validate(flags *flagset) error {
+	for _, file := range o.From {
+		if file == "" {
+			return fmt.Errorf("value of '--output-values-from' can't be empty")
+		}
+	}
+	if o.Enabled && len(o.From) == 0 {
+		if flags.changed("output-values-from") {
 			return fmt.Errorf("value of '--output-values-from' can't be empty")
 		}
 		return fmt.Errorf("value of '--output-values-from' is missing")
@@ -131,45 +281,284 @@ func (o *outputvalues) validate() error {
 	return nil
 }
 
-type sortby struct {
-	Required bool
-	Type     bool
+type output struct {
+	File  string `yaml:"file"`
+	Mode  string `yaml:"mode"`
+	Check bool   `yaml:"check"`
+}
+
+func defaultOutput() *output {
+	return &output{
+		File:  "",
+		Mode:  "inject",
+		Check: false,
+	}
+}
+
+func (o *output) validate(flags *flagset) error {
+	if flags.changed("output-file") && o.File == "" {
+		return fmt.Errorf("value of '--output-file' can't be empty")
+	}
+	if o.Mode != "inject" && o.Mode != "replace" {
+		return fmt.Errorf("value of '--output-mode' must be one of [inject, replace]")
+	}
+	if o.Check && o.File == "" {
+		return fmt.Errorf("value of '--output-file' is missing, '--check' compares the output with it")
+	}
+	return nil
+}
+
+type recursive struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
 }
+
+func defaultRecursive() *recursive {
+	return &recursive{
+		Enabled: false,
+		Path:    "modules",
+	}
+}
+
+func (r *recursive) validate(output *output, formatter string) error {
+	if !r.Enabled {
+		return nil
+	}
+	if r.Path == "" {
+		return fmt.Errorf("value of '--recursive-path' can't be empty")
+	}
+	if output.File == "" && formatter != "jsonl" {
+		return fmt.Errorf("value of '--output-file' is missing, '--recursive' writes into a file per module")
+	}
+	return nil
+}
+
+type filter struct {
+	IncludeInputs  []string `yaml:"include-inputs"`
+	ExcludeInputs  []string `yaml:"exclude-inputs"`
+	IncludeOutputs []string `yaml:"include-outputs"`
+	ExcludeOutputs []string `yaml:"exclude-outputs"`
+}
+
+func defaultFilter() *filter {
+	return &filter{
+		IncludeInputs:  []string{},
+		ExcludeInputs:  []string{},
+		IncludeOutputs: []string{},
+		ExcludeOutputs: []string{},
+	}
+}
+
+func (f *filter) validate() error {
+	items := []struct {
+		flag     string
+		patterns []string
+	}{
+		{"include-inputs", f.IncludeInputs},
+		{"exclude-inputs", f.ExcludeInputs},
+		{"include-outputs", f.IncludeOutputs},
+		{"exclude-outputs", f.ExcludeOutputs},
+	}
+	for _, item := range items {
+		for _, pattern := range item.patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("value of '--%s' is not a valid glob pattern: %s", item.flag, pattern)
+			}
+		}
+	}
+	return nil
+}
+
+const (
+	sortByName        = "name"
+	sortByRequired    = "required"
+	sortByType        = "type"
+	sortByDeclaration = "declaration"
+	sortByPosition    = "position" // alias of declaration
+)
+
+// aliases of sort criteria and the criteria they stand for
+var sortAliases = map[string]string{
+	sortByPosition: sortByDeclaration,
+}
+
+// sortmode is the comma-separated list of criteria which items are sorted
+// by [name, required, type, declaration, position], compared in turn (e.g. 'type,required').
+// In config file it can also be set with a list of criteria or with deprecated
+// 'required' and 'type' keys (e.g. 'sort.by.required: true').
+type sortmode string
+
+// UnmarshalYAML reads sortmode either from a string, a list or deprecated keys
+func (m *sortmode) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.SequenceNode {
+		var modes []string
+		if err := value.Decode(&modes); err != nil {
+			return err
+		}
+		*m = sortmode(strings.Join(modes, ","))
+		return nil
+	}
+	if value.Kind != yaml.MappingNode {
+		var mode string
+		if err := value.Decode(&mode); err != nil {
+			return err
+		}
+		*m = sortmode(mode)
+		return nil
+	}
+	var by struct {
+		Required bool `yaml:"required"`
+		Type     bool `yaml:"type"`
+	}
+	if err := value.Decode(&by); err != nil {
+		return err
+	}
+	switch {
+	case by.Required && by.Type:
+		*m = sortByRequired + "," + sortByType
+	case by.Required:
+		*m = sortByRequired
+	case by.Type:
+		*m = sortByType
+	default:
+		*m = sortByName
+	}
+	return nil
+}
+
+// keys returns the list of criteria of sortmode, in order, with their aliases
+// replaced by the criteria they stand for
+func (m sortmode) keys() []string {
+	keys := strings.Split(string(m), ",")
+	for i := range keys {
+		keys[i] = strings.TrimSpace(keys[i])
+		if key, ok := sortAliases[keys[i]]; ok {
+			keys[i] = key
+		}
+	}
+	return keys
+}
+
+// sortBy converts sortmode to module.SortBy
+func (m sortmode) sortBy(enabled bool) *module.SortBy {
+	keys := m.keys()
+	sortby := &module.SortBy{
+		Name:     enabled && !contains(keys, sortByDeclaration),
+		Required: enabled && contains(keys, sortByRequired),
+		Type:     enabled && contains(keys, sortByType),
+	}
+	if sortby.Name {
+		sortby.Keys = keys
+	}
+	return sortby
+}
+
 type _sort struct {
-	NoSort bool
+	NoSort     bool
+	ByRequired bool
+	ByType     bool
 }
 type sort struct {
-	Enabled    bool
-	By         *sortby
-	Deprecated *_sort
+	Enabled    bool     `yaml:"enabled"`
+	By         sortmode `yaml:"by"`
+	InputsBy   sortmode `yaml:"inputs-by"`
+	OutputsBy  sortmode `yaml:"outputs-by"`
+	ByPosition bool     `yaml:"by-position"`
+	Deprecated *_sort   `yaml:"-"`
+
+	inputs  sortmode
+	outputs sortmode
 }
 
 func defaultSort() *sort {
 	return &sort{
-		Enabled: true,
-		By: &sortby{
-			Required: false,
-			Type:     false,
-		},
+		Enabled:    true,
+		By:         sortByName,
+		InputsBy:   "",
+		OutputsBy:  "",
+		ByPosition: false,
 		Deprecated: &_sort{
-			NoSort: false,
+			NoSort:     false,
+			ByRequired: false,
+			ByType:     false,
 		},
+
+		inputs:  "",
+		outputs: "",
 	}
 }
 
-func (s *sort) validate() error {
+func (s *sort) validate(flags *flagset) error {
 	items := []string{"sort"}
 	for _, item := range items {
-		if changedfs[item] && changedfs["no-"+item] {
+		if flags.changed(item) && flags.changed("no-"+item) {
 			return fmt.Errorf("'--%s' and '--no-%s' can't be used together", item, item)
 		}
 	}
-	if s.By.Required && s.By.Type {
-		return fmt.Errorf("'--sort-by-required' and '--sort-by-type' can't be used together")
+	if s.ByPosition {
+		for _, flag := range []string{"sort-by", "sort-inputs-by", "sort-outputs-by", "sort-by-required", "sort-by-type"} {
+			if flags.changed(flag) {
+				return fmt.Errorf("'--sort-by-position' and '--%s' can't be used together", flag)
+			}
+		}
+	}
+	if err := validateSortBy("sort-by", s.By); err != nil {
+		return err
+	}
+	if s.InputsBy != "" {
+		if err := validateSortBy("sort-inputs-by", s.InputsBy); err != nil {
+			return err
+		}
+	}
+	if s.OutputsBy != "" {
+		if err := validateSortBy("sort-outputs-by", s.OutputsBy); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// sortmode of a specific section, falls back to '--sort-by' if
+// mode of the section is not explicitly set
+func (s *sort) section(mode sortmode) sortmode {
+	if mode == "" {
+		return s.By
+	}
+	return mode
+}
+
+func validateSortBy(flag string, mode sortmode) error {
+	items := []string{sortByName, sortByRequired, sortByType, sortByDeclaration}
+	keys := mode.keys()
+	for i, key := range keys {
+		if !contains(items, key) {
+			return fmt.Errorf("value of '--%s' must be one of %v, or a comma-separated list of them", flag, append(items, sortByPosition))
+		}
+		if contains(keys[:i], key) {
+			return fmt.Errorf("'%s' is repeated in '--%s'", key, flag)
+		}
+	}
+	if len(keys) > 1 && contains(keys, sortByDeclaration) {
+		return fmt.Errorf("'%s' of '--%s' can't be combined with other criteria", sortByDeclaration, flag)
+	}
+	return nil
+}
+
+// list of all the columns of inputs table which can be hidden
+var tableColumns = []string{"default", "type"}
+
+// list of all the modes of escaping special characters
+var escapeModes = []string{"all", "markdown", "none"}
+
+// list of all the styles of heading anchors, named after the platforms generating them
+var anchorStyles = []string{"github", "gitlab"}
+
+// list of all the styles of required and sensitive indicators
+var badgeStyles = []string{"text", "emoji", "shield"}
+
+// list of all the styles of booleans rendered by 'text' badge style
+var booleanStyles = []string{"yes-no", "true-false", "check-cross"}
+
 type _settings struct {
 	NoColor     bool
 	NoEscape    bool
@@ -177,21 +566,111 @@ type _settings struct {
 	NoSensitive bool
 }
 type settings struct {
-	Color      bool
-	Escape     bool
-	Indent     int
-	Required   bool
-	Sensitive  bool
-	Deprecated *_settings
+	Anchor                 bool       `yaml:"anchor"`
+	AnchorStyle            string     `yaml:"anchor-style"`
+	BadgeStyle             string     `yaml:"badge-style"`
+	BooleanStyle           string     `yaml:"boolean-style"`
+	Collapse               bool       `yaml:"collapse-descriptions"`
+	CollapseLength         int        `yaml:"collapse-threshold"`
+	Color                  bool       `yaml:"color"`
+	Compact                bool       `yaml:"compact"`
+	DefaultsAsHCL          bool       `yaml:"defaults-as-hcl"`
+	DependsOn              bool       `yaml:"depends-on"`
+	Escape                 bool       `yaml:"escape"`
+	EscapeMode             string     `yaml:"escape-mode"`
+	ExtractExamples        bool       `yaml:"extract-examples"`
+	FormatTypes            bool       `yaml:"format-complex-types"`
+	GroupByFile            bool       `yaml:"group-by-file"`
+	HeadingBaseLevel       int        `yaml:"heading-base-level"`
+	HideColumns            []string   `yaml:"hide-columns"`
+	HideEmpty              bool       `yaml:"hide-empty"`
+	Indent                 int        `yaml:"indent"`
+	InputValues            bool       `yaml:"input-values"`
+	InputsAsSubsections    bool       `yaml:"inputs-as-subsections"`
+	LinkResources          bool       `yaml:"link-resources"`
+	Lockfile               bool       `yaml:"lockfile"`
+	MaxLineLength          int        `yaml:"max-line-length"`
+	MetaTimestamp          bool       `yaml:"meta-timestamp"`
+	NoEmptyDefaults        bool       `yaml:"no-empty-defaults"`
+	NormalizeModuleSources bool       `yaml:"normalize-module-sources"`
+	NormalizeTypes         bool       `yaml:"normalize-types"`
+	Nullable               bool       `yaml:"nullable"`
+	PartitionSensitive     bool       `yaml:"partition-sensitive-outputs"`
+	Placeholder            string     `yaml:"placeholder"`
+	ProviderNamespace      bool       `yaml:"provider-namespace"`
+	RawDescriptions        bool       `yaml:"raw-descriptions"`
+	ReadComments           bool       `yaml:"read-comments"`
+	Required               bool       `yaml:"required"`
+	Sensitive              bool       `yaml:"sensitive"`
+	SensitiveAlerts        bool       `yaml:"sensitive-alerts"`
+	SensitiveMark          string     `yaml:"sensitive-mark"`
+	ShowSummary            bool       `yaml:"show-summary"`
+	ShowTOC                bool       `yaml:"show-toc"`
+	Split                  bool       `yaml:"split-requirements"`
+	SplitRequiredOptional  bool       `yaml:"split-required-optional"`
+	TerraformRequirement   bool       `yaml:"terraform-requirement"`
+	TrimDescription        bool       `yaml:"trim-description"`
+	TypeMaxLength          int        `yaml:"type-max-length"`
+	Validation             bool       `yaml:"validation"`
+	VersionSource          bool       `yaml:"version-constraint"`
+	WrapAt                 int        `yaml:"wrap-at"`
+	NoTypeColumn           bool       `yaml:"-"`
+	NoDefaultColumn        bool       `yaml:"-"`
+	Deprecated             *_settings `yaml:"-"`
 }
 
 func defaultSettings() *settings {
 	return &settings{
-		Color:     true,
-		Escape:    true,
-		Indent:    2,
-		Required:  true,
-		Sensitive: true,
+		Anchor:                 false,
+		AnchorStyle:            "github",
+		BadgeStyle:             "text",
+		BooleanStyle:           "yes-no",
+		Collapse:               false,
+		CollapseLength:         200,
+		Color:                  true,
+		Compact:                false,
+		DefaultsAsHCL:          false,
+		DependsOn:              false,
+		Escape:                 true,
+		EscapeMode:             "markdown",
+		ExtractExamples:        false,
+		FormatTypes:            false,
+		GroupByFile:            false,
+		HeadingBaseLevel:       2,
+		HideColumns:            []string{},
+		HideEmpty:              false,
+		Indent:                 2,
+		InputValues:            false,
+		InputsAsSubsections:    false,
+		LinkResources:          false,
+		Lockfile:               false,
+		MaxLineLength:          0,
+		MetaTimestamp:          true,
+		NoEmptyDefaults:        false,
+		NormalizeModuleSources: false,
+		NormalizeTypes:         false,
+		Nullable:               false,
+		PartitionSensitive:     false,
+		Placeholder:            "n/a",
+		ProviderNamespace:      false,
+		RawDescriptions:        false,
+		ReadComments:           true,
+		Required:               true,
+		Sensitive:              true,
+		SensitiveAlerts:        false,
+		SensitiveMark:          "yes",
+		ShowSummary:            false,
+		ShowTOC:                false,
+		Split:                  false,
+		SplitRequiredOptional:  false,
+		TerraformRequirement:   true,
+		Validation:             false,
+		TrimDescription:        false,
+		TypeMaxLength:          0,
+		VersionSource:          false,
+		WrapAt:                 0,
+		NoTypeColumn:           false,
+		NoDefaultColumn:        false,
 		Deprecated: &_settings{
 			NoColor:     false,
 			NoEscape:    false,
@@ -201,99 +680,643 @@ func defaultSettings() *settings {
 	}
 }
 
-func (s *settings) validate() error {
+func (s *settings) validate(flags *flagset) error {
 	items := []string{"escape", "color", "required", "sensitive"}
 	for _, item := range items {
-		if changedfs[item] && changedfs["no-"+item] {
+		if flags.changed(item) && flags.changed("no-"+item) {
 			return fmt.Errorf("'--%s' and '--no-%s' can't be used together", item, item)
 		}
 	}
+	for _, column := range s.HideColumns {
+		if !contains(tableColumns, column) {
+			return fmt.Errorf("'%s' is not a valid column of 'hide-columns'", column)
+		}
+	}
+	if s.Indent < 0 {
+		return fmt.Errorf("value of '--indent' can't be negative")
+	}
+	if !contains(anchorStyles, s.AnchorStyle) {
+		return fmt.Errorf("value of '--anchor-style' must be one of %v", anchorStyles)
+	}
+	if strings.TrimSpace(s.SensitiveMark) == "" {
+		return fmt.Errorf("value of '--sensitive-mark' can't be empty")
+	}
+	if !contains(badgeStyles, s.BadgeStyle) {
+		return fmt.Errorf("value of '--badge-style' must be one of %v", badgeStyles)
+	}
+	if !contains(booleanStyles, s.BooleanStyle) {
+		return fmt.Errorf("value of '--boolean-style' must be one of %v", booleanStyles)
+	}
+	// boolean style and sensitive mark only apply to the default text badges
+	if s.BooleanStyle != "yes-no" && s.BadgeStyle != "text" {
+		return fmt.Errorf("'--boolean-style' and '--badge-style %s' can't be used together", s.BadgeStyle)
+	}
+	if flags.changed("sensitive-mark") && s.BadgeStyle != "text" {
+		return fmt.Errorf("'--sensitive-mark' and '--badge-style %s' can't be used together", s.BadgeStyle)
+	}
+	if flags.changed("sensitive-mark") && s.BooleanStyle != "yes-no" {
+		return fmt.Errorf("'--sensitive-mark' and '--boolean-style %s' can't be used together", s.BooleanStyle)
+	}
+	if !contains(escapeModes, s.EscapeMode) {
+		return fmt.Errorf("value of '--escape-mode' must be one of %v", escapeModes)
+	}
+	if s.HeadingBaseLevel < 1 || s.HeadingBaseLevel > 5 {
+		return fmt.Errorf("value of '--heading-base-level' must be between 1 and 5")
+	}
+	if s.CollapseLength < 0 {
+		return fmt.Errorf("value of '--collapse-threshold' can't be negative")
+	}
+	if s.MaxLineLength < 0 {
+		return fmt.Errorf("value of '--max-line-length' can't be negative")
+	}
+	if s.TypeMaxLength < 0 || (flags.changed("type-max-length") && s.TypeMaxLength == 0) {
+		return fmt.Errorf("value of '--type-max-length' must be positive")
+	}
+	if s.WrapAt < 0 {
+		return fmt.Errorf("value of '--wrap-at' can't be negative")
+	}
+	return nil
+}
+
+// pathlist is a list of relative paths of files, which can be read from
+// either a string or a list of strings (e.g. 'header-from: main.tf')
+type pathlist []string
+
+// UnmarshalYAML reads pathlist either from a string or a list of strings
+func (p *pathlist) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		var path string
+		if err := value.Decode(&path); err != nil {
+			return err
+		}
+		*p = pathlist{path}
+		return nil
+	}
+	var paths []string
+	if err := value.Decode(&paths); err != nil {
+		return err
+	}
+	*p = paths
+	return nil
+}
+
+// target is an additional output of the module, rendered with 'Formatter'
+// and written into 'File', relative to the module. The whole file gets
+// replaced by default, as not all the formats can hold markers to inject
+// the output between.
+type target struct {
+	Formatter string `yaml:"formatter"`
+	File      string `yaml:"file"`
+	Mode      string `yaml:"mode"`
+}
+
+// targetlist is a list of targets, which can be set through CLI with
+// 'formatter=file' values (e.g. --target "markdown table=README.md")
+type targetlist []*target
+
+// String returns targetlist as comma-separated 'formatter=file' values
+func (t *targetlist) String() string {
+	items := make([]string, 0, len(*t))
+	for _, item := range *t {
+		items = append(items, item.Formatter+"="+item.File)
+	}
+	return strings.Join(items, ",")
+}
+
+// Set appends the target of a 'formatter=file' value to targetlist
+func (t *targetlist) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("'%s' must be in the form of 'formatter=file'", value)
+	}
+	*t = append(*t, &target{
+		Formatter: strings.TrimSpace(parts[0]),
+		File:      strings.TrimSpace(parts[1]),
+		Mode:      "replace",
+	})
 	return nil
 }
 
+// Type returns the type of targetlist shown in usage of the flag
+func (t *targetlist) Type() string {
+	return "stringArray"
+}
+
 // Config represents all the available config options that can be accessed and passed through CLI
 type Config struct {
-	Formatter    string
-	HeaderFrom   string
-	Sections     *sections
-	OutputValues *outputvalues
-	Sort         *sort
-	Settings     *settings
+	File                     string        `yaml:"-"`
+	Formatter                string        `yaml:"-"`
+	Source                   string        `yaml:"-"`
+	HeaderFrom               pathlist      `yaml:"header-from"`
+	FooterFrom               string        `yaml:"footer-from"`
+	IncludeExamples          string        `yaml:"include-examples"`
+	DefaultValues            string        `yaml:"default-values-file"`
+	Sections                 *sections     `yaml:"sections"`
+	Filter                   *filter       `yaml:"filter"`
+	Output                   *output       `yaml:"output"`
+	Targets                  targetlist    `yaml:"targets"`
+	OutputTemplate           string        `yaml:"output-template"`
+	ReadmeTemplate           string        `yaml:"readme-template"`
+	PostProcess              string        `yaml:"post-process"`
+	OutputValues             *outputvalues `yaml:"output-values"`
+	Recursive                *recursive    `yaml:"recursive"`
+	Parallelism              int           `yaml:"parallelism"`
+	Catalog                  bool          `yaml:"catalog"`
+	Diff                     string        `yaml:"diff"`
+	Quiet                    bool          `yaml:"quiet"`
+	Strict                   bool          `yaml:"strict"`
+	FailOnMissingDescription bool          `yaml:"fail-on-missing-description"`
+	Sort                     *sort         `yaml:"sort"`
+	Settings                 *settings     `yaml:"settings"`
+
+	flags      *flagset // flagset items which explicitly changed in this run
+	template   string   // content of 'OutputTemplate' file
+	sourceDir  string   // temporary directory 'Source' is fetched into
+	sourcePath string   // path of the module fetched from 'Source'
 }
 
 // DefaultConfig returns new instance of Config with default values set
 func DefaultConfig() *Config {
 	return &Config{
-		Formatter:    "",
-		HeaderFrom:   "main.tf",
-		Sections:     defaultSections(),
-		OutputValues: defaultOutputValues(),
-		Sort:         defaultSort(),
-		Settings:     defaultSettings(),
+		File:                     ".terraform-docs.yml",
+		Formatter:                "",
+		Source:                   "",
+		HeaderFrom:               pathlist{"main.tf"},
+		FooterFrom:               "",
+		IncludeExamples:          "",
+		DefaultValues:            "",
+		Sections:                 defaultSections(),
+		Filter:                   defaultFilter(),
+		Output:                   defaultOutput(),
+		Targets:                  targetlist{},
+		OutputTemplate:           "",
+		ReadmeTemplate:           "",
+		PostProcess:              "",
+		OutputValues:             defaultOutputValues(),
+		Recursive:                defaultRecursive(),
+		Parallelism:              1,
+		Catalog:                  false,
+		Diff:                     "",
+		Quiet:                    false,
+		Strict:                   false,
+		FailOnMissingDescription: false,
+		Sort:                     defaultSort(),
+		Settings:                 defaultSettings(),
+		flags:                    newFlagset(),
 	}
 }
 
+// clone returns a deep copy of Config, so it can be normalized and validated
+// without modifying the original one (e.g. the one passed to Generate)
+func (c *Config) clone() *Config {
+	copyOf := func(list []string) []string {
+		if list == nil {
+			return nil
+		}
+		return append(make([]string, 0, len(list)), list...)
+	}
+
+	config := *c
+	config.HeaderFrom = copyOf(c.HeaderFrom)
+
+	sections := *c.Sections
+	sections.Show = copyOf(c.Sections.Show)
+	sections.Hide = copyOf(c.Sections.Hide)
+	sections.Order = copyOf(c.Sections.Order)
+	if c.Sections.Titles != nil {
+		sections.Titles = make(map[string]string, len(c.Sections.Titles))
+		for k, v := range c.Sections.Titles {
+			sections.Titles[k] = v
+		}
+	}
+	if c.Sections.Deprecated != nil {
+		deprecated := *c.Sections.Deprecated
+		sections.Deprecated = &deprecated
+	}
+	config.Sections = &sections
+
+	filter := *c.Filter
+	filter.IncludeInputs = copyOf(c.Filter.IncludeInputs)
+	filter.ExcludeInputs = copyOf(c.Filter.ExcludeInputs)
+	filter.IncludeOutputs = copyOf(c.Filter.IncludeOutputs)
+	filter.ExcludeOutputs = copyOf(c.Filter.ExcludeOutputs)
+	config.Filter = &filter
+
+	output := *c.Output
+	config.Output = &output
+
+	config.Targets = make(targetlist, 0, len(c.Targets))
+	for _, t := range c.Targets {
+		target := *t
+		config.Targets = append(config.Targets, &target)
+	}
+
+	outputValues := *c.OutputValues
+	outputValues.From = copyOf(c.OutputValues.From)
+	config.OutputValues = &outputValues
+
+	recursive := *c.Recursive
+	config.Recursive = &recursive
+
+	sorting := *c.Sort
+	if c.Sort.Deprecated != nil {
+		deprecated := *c.Sort.Deprecated
+		sorting.Deprecated = &deprecated
+	}
+	config.Sort = &sorting
+
+	settings := *c.Settings
+	settings.HideColumns = copyOf(c.Settings.HideColumns)
+	if c.Settings.Deprecated != nil {
+		deprecated := *c.Settings.Deprecated
+		settings.Deprecated = &deprecated
+	}
+	config.Settings = &settings
+
+	config.flags = newFlagset()
+	if c.flags != nil {
+		c.flags.RLock()
+		for name, changed := range c.flags.items {
+			config.flags.items[name] = changed
+		}
+		for name, env := range c.flags.env {
+			config.flags.env[name] = env
+		}
+		for name, fetched := range c.flags.fetched {
+			config.flags.fetched[name] = fetched
+		}
+		config.flags.deprecated = append(config.flags.deprecated, c.flags.deprecated...)
+		c.flags.RUnlock()
+	}
+
+	return &config
+}
+
 // normalize provided Config
-func (c *Config) normalize(command string) {
-	c.Formatter = strings.Replace(command, "terraform-docs ", "", -1)
+func (c *Config) normalize() {
+	// source, the module is removed after generation so nothing set in
+	// its config file can be written into it
+	if c.Source != "" {
+		// the config file of the fetched repository can't be trusted to
+		// write files or read them from outside of the module, only values
+		// explicitly set from CLI or a local config file are kept. Dropped
+		// values are no longer considered as explicitly set.
+		fetched := func(name string) bool {
+			if !c.flags.fromSource(name) {
+				return false
+			}
+			c.flags.set(name, false)
+			return true
+		}
+		untrusted := func(name string) bool {
+			return fetched(name) || !c.flags.changed(name)
+		}
+		if untrusted("output-file") {
+			c.Output.File = ""
+		}
+		if untrusted("recursive") {
+			c.Recursive.Enabled = false
+		}
+		if untrusted("target") {
+			c.Targets = targetlist{}
+		}
+		if fetched("readme-template") {
+			c.ReadmeTemplate = ""
+		}
+		if fetched("header-from") {
+			c.HeaderFrom = pathlist{"main.tf"}
+		}
+		if fetched("footer-from") {
+			c.FooterFrom = ""
+		}
+		if fetched("include-examples") {
+			c.IncludeExamples = ""
+		}
+		if fetched("default-values-file") {
+			c.DefaultValues = ""
+		}
+		if fetched("diff") {
+			c.Diff = ""
+		}
+	}
 
-	// sections
-	if c.Sections.HideAll && !changedfs["show-all"] {
+	// sections, '--only' is showing just one section without its heading
+	if c.Sections.Only != "" {
+		c.Sections.Show = []string{c.Sections.Only}
+		c.Sections.Hide = []string{}
 		c.Sections.ShowAll = false
+		c.Sections.HideAll = true
 	}
-	if !c.Sections.ShowAll && !changedfs["hide-all"] {
+
+	// '--show' on its own means showing only the listed ones
+	if len(c.Sections.Show) != 0 && !c.flags.changed("show-all") && !c.flags.changed("hide-all") {
+		c.Sections.HideAll = true
+	}
+	if c.Sections.HideAll && !c.flags.changed("show-all") {
+		c.Sections.ShowAll = false
+	}
+	if !c.Sections.ShowAll && !c.flags.changed("hide-all") {
 		c.Sections.HideAll = true
 	}
+	c.Sections.checks = c.Sections.visibility("checks") && contains(c.Sections.Show, "checks") // off by default
+	c.Sections.dataSources = c.Sections.visibility("data-sources")
+	c.Sections.footer = c.Sections.visibility("footer") && c.FooterFrom != ""
 	c.Sections.header = c.Sections.visibility("header")
+	c.Sections.imports = c.Sections.visibility("imports") && contains(c.Sections.Show, "imports") // off by default
 	c.Sections.inputs = c.Sections.visibility("inputs")
+	c.Sections.meta = c.Sections.visibility("meta") && contains(c.Sections.Show, "meta") // off by default
+	c.Sections.modules = c.Sections.visibility("modules")
+	c.Sections.moved = c.Sections.visibility("moved") && contains(c.Sections.Show, "moved") // off by default
 	c.Sections.outputs = c.Sections.visibility("outputs")
 	c.Sections.providers = c.Sections.visibility("providers")
 	c.Sections.requirements = c.Sections.visibility("requirements")
+	c.Sections.resources = c.Sections.visibility("resources")
+	c.Sections.usage = c.Sections.visibility("usage") && c.IncludeExamples != ""
+
+	if c.Sections.Deprecated.NoFooter {
+		c.Sections.footer = false
+	}
+	if c.Sections.Deprecated.NoHeader {
+		c.Sections.header = false
+	}
+	if c.Sections.Deprecated.NoInputs {
+		c.Sections.inputs = false
+	}
+	if c.Sections.Deprecated.NoModules {
+		c.Sections.modules = false
+	}
+	if c.Sections.Deprecated.NoOutputs {
+		c.Sections.outputs = false
+	}
+	if c.Sections.Deprecated.NoProviders {
+		c.Sections.providers = false
+	}
+	if c.Sections.Deprecated.NoRequirements {
+		c.Sections.requirements = false
+	}
+	if c.Sections.Deprecated.NoResources {
+		c.Sections.resources = false
+	}
 
 	// sort
-	if !changedfs["sort"] {
+	if !c.flags.changed("sort") {
 		c.Sort.Enabled = !c.Sort.Deprecated.NoSort
 	}
+	if !c.flags.changed("sort-by") {
+		keys := []string{}
+		if c.Sort.Deprecated.ByRequired {
+			keys = append(keys, sortByRequired)
+		}
+		if c.Sort.Deprecated.ByType {
+			keys = append(keys, sortByType)
+		}
+		if len(keys) != 0 {
+			c.Sort.By = sortmode(strings.Join(keys, ","))
+		}
+	}
+	if c.Sort.ByPosition {
+		c.Sort.By = sortByDeclaration
+	}
+	c.Sort.inputs = c.Sort.section(c.Sort.InputsBy)
+	c.Sort.outputs = c.Sort.section(c.Sort.OutputsBy)
 
 	// settings
-	if !changedfs["escape"] {
+	if !c.flags.changed("escape") {
 		c.Settings.Escape = !c.Settings.Deprecated.NoEscape
 	}
-	if !changedfs["color"] {
+	if !c.flags.changed("escape-mode") && (c.flags.changed("escape") || c.flags.changed("no-escape")) {
+		c.Settings.EscapeMode = "markdown"
+		if !c.Settings.Escape {
+			c.Settings.EscapeMode = "none"
+		}
+	}
+	if !c.flags.changed("color") {
 		c.Settings.Color = !c.Settings.Deprecated.NoColor
 	}
-	if !changedfs["required"] {
+	if !c.flags.changed("required") {
 		c.Settings.Required = !c.Settings.Deprecated.NoRequired
 	}
-	if !changedfs["sensitive"] {
+	if !c.flags.changed("sensitive") {
 		c.Settings.Sensitive = !c.Settings.Deprecated.NoSensitive
 	}
+	// '--indent' used to be the level of headings of asciidoc and markdown
+	// formats, it only indents the output of the others. Levels out of range
+	// fall back to the default, the same as they used to.
+	if !c.flags.changed("heading-base-level") && c.flags.changed("indent") && c.Settings.Indent >= 1 && c.Settings.Indent <= 5 {
+		if strings.HasPrefix(c.Formatter, "asciidoc") || strings.HasPrefix(c.Formatter, "markdown") {
+			c.Settings.HeadingBaseLevel = c.Settings.Indent
+		}
+	}
+	if c.flags.changed("no-type-column") {
+		c.Settings.HideColumns = toggle(c.Settings.HideColumns, "type", c.Settings.NoTypeColumn)
+	}
+	if c.flags.changed("no-default-column") {
+		c.Settings.HideColumns = toggle(c.Settings.HideColumns, "default", c.Settings.NoDefaultColumn)
+	}
 }
 
 // validate config and check for any misuse or misconfiguration
 func (c *Config) validate() error {
+	// source
+	if c.flags.changed("source") && c.Source == "" {
+		return fmt.Errorf("value of '--source' can't be empty")
+	}
+	if c.Source != "" {
+		if _, err := parseSource(c.Source); err != nil {
+			return fmt.Errorf("value of '--source' is not a valid git source: %s", err)
+		}
+		if c.Output.File != "" {
+			return fmt.Errorf("'--source' and '--output-file' can't be used together")
+		}
+		if len(c.Targets) != 0 {
+			return fmt.Errorf("'--source' and '--target' can't be used together")
+		}
+	}
+
 	// header-from
-	if c.HeaderFrom == "" {
+	if len(c.HeaderFrom) == 0 {
 		return fmt.Errorf("value of '--header-from' can't be empty")
 	}
+	for _, file := range c.HeaderFrom {
+		if file == "" {
+			return fmt.Errorf("value of '--header-from' can't be empty")
+		}
+	}
+
+	// footer-from
+	if c.flags.changed("footer-from") && c.FooterFrom == "" {
+		return fmt.Errorf("value of '--footer-from' can't be empty")
+	}
+	if contains(c.Sections.Show, "footer") && c.FooterFrom == "" {
+		return fmt.Errorf("value of '--footer-from' is missing, it's required to show footer")
+	}
+
+	// include-examples
+	if c.flags.changed("include-examples") && c.IncludeExamples == "" {
+		return fmt.Errorf("value of '--include-examples' can't be empty")
+	}
+	if contains(c.Sections.Show, "usage") && c.IncludeExamples == "" {
+		return fmt.Errorf("value of '--include-examples' is missing, it's required to show usage")
+	}
+
+	// default-values-file
+	if c.flags.changed("default-values-file") && c.DefaultValues == "" {
+		return fmt.Errorf("value of '--default-values-file' can't be empty")
+	}
 
 	// sections
-	if err := c.Sections.validate(); err != nil {
+	if err := c.Sections.validate(c.flags); err != nil {
 		return err
 	}
 
+	// filter
+	if err := c.Filter.validate(); err != nil {
+		return err
+	}
+
+	// output
+	if err := c.Output.validate(c.flags); err != nil {
+		return err
+	}
+
+	// targets
+	for _, t := range c.Targets {
+		if t.Formatter == "" || t.File == "" {
+			return fmt.Errorf("value of '--target' must be in the form of 'formatter=file'")
+		}
+		if _, err := format.Factory(t.Formatter, print.NewSettings()); err != nil {
+			return fmt.Errorf("value of '--target' is invalid: %s", err)
+		}
+		if t.Mode == "" {
+			t.Mode = "replace"
+		}
+		if t.Mode != "inject" && t.Mode != "replace" {
+			return fmt.Errorf("mode of '--target' %s must be one of [inject, replace]", t.File)
+		}
+	}
+
+	// output template
+	if c.flags.fromSource("output-template") {
+		// a fetched repository must never read files of this machine into the output
+		return fmt.Errorf("'output-template' of the config file of '--source' can't be read, it's only allowed from CLI or a local config file")
+	}
+	if c.formatters()["template"] {
+		if c.OutputTemplate == "" {
+			return fmt.Errorf("value of '--output-template' can't be empty")
+		}
+		content, err := ioutil.ReadFile(c.OutputTemplate)
+		if err != nil {
+			return fmt.Errorf("value of '--output-template' is not a readable file: %s", err)
+		}
+		settings := print.NewSettings()
+		settings.Template = string(content)
+		if _, err := format.NewTemplate(settings); err != nil {
+			return fmt.Errorf("value of '--output-template' is not a valid template: %s", err)
+		}
+		c.template = settings.Template
+	}
+
+	// readme template, read from each module as they're rendered
+	if c.flags.changed("readme-template") && c.ReadmeTemplate == "" {
+		return fmt.Errorf("value of '--readme-template' can't be empty")
+	}
+	if c.ReadmeTemplate != "" && c.Catalog {
+		return fmt.Errorf("'--readme-template' and '--catalog' can't be used together")
+	}
+
+	// post-process command, run on the output before it's written or printed
+	if c.flags.changed("post-process") && strings.TrimSpace(c.PostProcess) == "" {
+		return fmt.Errorf("value of '--post-process' can't be empty")
+	}
+	if c.flags.fromSource("post-process") {
+		// a fetched repository must never run commands on this machine
+		return fmt.Errorf("'post-process' of the config file of '--source' can't be run, it's only allowed from CLI or a local config file")
+	}
+	if c.PostProcess != "" && c.Formatter == "jsonl" {
+		return fmt.Errorf("'jsonl' streams modules into stdout, '--post-process' can't be used")
+	}
+
 	// output values
-	if err := c.OutputValues.validate(); err != nil {
+	if c.flags.fromSource("output-values-from") {
+		// a fetched repository must never read files of this machine into the output
+		return fmt.Errorf("'output-values.from' of the config file of '--source' can't be read, it's only allowed from CLI or a local config file")
+	}
+	if err := c.OutputValues.validate(c.flags); err != nil {
 		return err
 	}
+	if c.Settings.InputValues && !c.OutputValues.Enabled {
+		return fmt.Errorf("value of '--output-values' is missing, it's required to show input values")
+	}
+
+	// recursive
+	if err := c.Recursive.validate(c.Output, c.Formatter); err != nil {
+		return err
+	}
+	if c.Parallelism < 1 {
+		return fmt.Errorf("value of '--parallelism' must be greater than zero")
+	}
+
+	// json lines
+	if c.Formatter == "jsonl" {
+		if c.Output.File != "" {
+			return fmt.Errorf("'jsonl' streams modules into stdout, '--output-file' can't be used")
+		}
+		if len(c.Targets) != 0 {
+			return fmt.Errorf("'jsonl' and '--target' can't be used together")
+		}
+	}
+
+	// catalog
+	if c.Catalog {
+		if !strings.HasPrefix(c.Formatter, "markdown") {
+			return fmt.Errorf("'--catalog' is only supported by markdown formatters")
+		}
+		if c.Recursive.Enabled {
+			return fmt.Errorf("'--catalog' and '--recursive' can't be used together")
+		}
+		if len(c.Targets) != 0 {
+			return fmt.Errorf("'--catalog' and '--target' can't be used together")
+		}
+		// headings of modules are nested one level down under their own one
+		if c.Settings.HeadingBaseLevel > 4 {
+			return fmt.Errorf("value of '--heading-base-level' must be between 1 and 4 with '--catalog'")
+		}
+	}
+
+	// diff, comparing the module with another version of it
+	if c.flags.changed("diff") && c.Diff == "" {
+		return fmt.Errorf("value of '--diff' can't be empty")
+	}
+	if c.Diff != "" {
+		if !strings.HasPrefix(c.Formatter, "markdown") {
+			return fmt.Errorf("'--diff' is only supported by markdown formatters")
+		}
+		if info, err := os.Stat(c.Diff); err != nil {
+			return fmt.Errorf("value of '--diff' is not a readable directory: %s", err)
+		} else if !info.IsDir() {
+			return fmt.Errorf("value of '--diff' is not a directory: %s", c.Diff)
+		}
+		if c.Catalog {
+			return fmt.Errorf("'--diff' and '--catalog' can't be used together")
+		}
+		if c.Recursive.Enabled {
+			return fmt.Errorf("'--diff' and '--recursive' can't be used together")
+		}
+		if len(c.Targets) != 0 {
+			return fmt.Errorf("'--diff' and '--target' can't be used together")
+		}
+		if c.ReadmeTemplate != "" {
+			return fmt.Errorf("'--diff' and '--readme-template' can't be used together")
+		}
+	}
 
 	// sort
-	if err := c.Sort.validate(); err != nil {
+	if err := c.Sort.validate(c.flags); err != nil {
 		return err
 	}
 
 	// settings
-	if err := c.Settings.validate(); err != nil {
+	if err := c.Settings.validate(c.flags); err != nil {
 		return err
 	}
 
@@ -306,39 +1329,161 @@ func (c *Config) extract() (*print.Settings, *module.Options) {
 	options := module.NewOptions()
 
 	// header-from
-	options.HeaderFromFile = c.HeaderFrom
+	options.HeaderFromFiles = c.HeaderFrom
+
+	// footer-from
+	options.FooterFromFile = c.FooterFrom
+
+	// include-examples
+	options.UsageFromFile = c.IncludeExamples
+
+	// default-values-file
+	options.DefaultValuesPath = c.DefaultValues
 
 	// sections
+	settings.HideHeadings = c.Sections.Only != ""
+	settings.HideEmpty = c.Settings.HideEmpty
+	settings.ShowDataSources = c.Sections.dataSources
+	settings.ShowFooter = c.Sections.footer
 	settings.ShowHeader = c.Sections.header
 	settings.ShowInputs = c.Sections.inputs
+	settings.ShowModules = c.Sections.modules
+	settings.ShowMoved = c.Sections.moved
+	settings.ShowChecks = c.Sections.checks
+	settings.ShowImports = c.Sections.imports
+	settings.ShowMeta = c.Sections.meta
 	settings.ShowOutputs = c.Sections.outputs
 	settings.ShowProviders = c.Sections.providers
 	settings.ShowRequirements = c.Sections.requirements
+	settings.ShowResources = c.Sections.resources
+	settings.ShowUsage = c.Sections.usage
+	settings.SectionTitles = c.Sections.Titles
+	settings.SectionsOrder = c.Sections.Order
+	options.ShowFooter = settings.ShowFooter
 	options.ShowHeader = settings.ShowHeader
+	options.ShowResources = settings.ShowResources
+	options.ShowDataSources = settings.ShowDataSources
+	options.ShowModules = settings.ShowModules
+	options.ShowMoved = settings.ShowMoved
+	options.ShowChecks = settings.ShowChecks
+	options.ShowImports = settings.ShowImports
+	options.ShowUsage = settings.ShowUsage
+
+	// filter
+	options.IncludeInputs = c.Filter.IncludeInputs
+	options.ExcludeInputs = c.Filter.ExcludeInputs
+	options.IncludeOutputs = c.Filter.IncludeOutputs
+	options.ExcludeOutputs = c.Filter.ExcludeOutputs
+
+	// output template
+	settings.Template = c.template
 
 	// output values
 	settings.OutputValues = c.OutputValues.Enabled
 	options.OutputValues = c.OutputValues.Enabled
-	options.OutputValuesPath = c.OutputValues.From
+	options.OutputValuesPaths = c.OutputValues.From
+	settings.ShowInputValues = c.Settings.InputValues
+	options.ShowInputValues = c.Settings.InputValues
 
 	// sort
-	settings.SortByName = c.Sort.Enabled
-	settings.SortByRequired = c.Sort.Enabled && c.Sort.By.Required
-	settings.SortByType = c.Sort.Enabled && c.Sort.By.Type
-	options.SortBy.Name = settings.SortByName
-	options.SortBy.Required = settings.SortByRequired
-	options.SortBy.Type = settings.SortByType
+	options.SortBy = c.Sort.By.sortBy(c.Sort.Enabled)
+	options.SortInputsBy = c.Sort.inputs.sortBy(c.Sort.Enabled)
+	options.SortOutputsBy = c.Sort.outputs.sortBy(c.Sort.Enabled)
+	settings.SortByName = options.SortBy.Name
+	settings.SortByRequired = options.SortBy.Required
+	settings.SortByType = options.SortBy.Type
 
 	// settings
-	settings.EscapeCharacters = c.Settings.Escape
+	settings.ShowAnchor = c.Settings.Anchor
+	settings.CollapseDescriptions = c.Settings.Collapse
+	settings.CollapseThreshold = c.Settings.CollapseLength
+	settings.ExtractExamples = c.Settings.ExtractExamples
+	settings.EscapeMode = c.Settings.EscapeMode
+	settings.DefaultsAsHCL = c.Settings.DefaultsAsHCL
+	settings.AnchorStyle = c.Settings.AnchorStyle
+	settings.BadgeStyle = c.Settings.BadgeStyle
+	settings.BooleanStyle = c.Settings.BooleanStyle
+	settings.FormatComplexTypes = c.Settings.FormatTypes
+	settings.GroupByFile = c.Settings.GroupByFile
+	settings.HeadingBaseLevel = c.Settings.HeadingBaseLevel
 	settings.IndentLevel = c.Settings.Indent
+	settings.InputsAsSubsections = c.Settings.InputsAsSubsections
+	settings.Compact = c.Settings.Compact
+	settings.PartitionSensitiveOutputs = c.Settings.PartitionSensitive
+	settings.Placeholder = c.Settings.Placeholder
+	settings.RawDescriptions = c.Settings.RawDescriptions
+	settings.MaxLineLength = c.Settings.MaxLineLength
+	settings.MetaTimestamp = c.Settings.MetaTimestamp
+	settings.MarkMissingDefaults = c.Settings.NoEmptyDefaults
+	settings.ShowNullable = c.Settings.Nullable
+	options.ShowNullable = c.Settings.Nullable
+	settings.ShowValidation = c.Settings.Validation
+	options.ShowValidation = c.Settings.Validation
+	settings.ShowDependsOn = c.Settings.DependsOn
+	options.ShowDependsOn = c.Settings.DependsOn
+	options.ReadComments = c.Settings.ReadComments
+	options.ShowTerraformCore = c.Settings.TerraformRequirement
+	options.NormalizeModuleSources = c.Settings.NormalizeModuleSources
+	options.NormalizeTypes = c.Settings.NormalizeTypes
+	options.Strict = c.Strict
+	options.ConfinedFiles = c.confinedFiles()
+	settings.ShowLockedVersions = c.Settings.Lockfile
+	options.ShowLockedVersions = c.Settings.Lockfile
+	settings.ShowProviderSources = c.Settings.ProviderNamespace
+	settings.LinkResources = c.Settings.LinkResources
+	options.LinkResources = c.Settings.LinkResources
+	options.ShowProviderSources = c.Settings.ProviderNamespace
 	settings.ShowColor = c.Settings.Color
+	settings.HiddenColumns = c.Settings.HideColumns
 	settings.ShowRequired = c.Settings.Required
 	settings.ShowSensitivity = c.Settings.Sensitive
+	settings.SensitiveAlerts = c.Settings.SensitiveAlerts
+	settings.SensitiveMark = c.Settings.SensitiveMark
+	settings.ShowSummary = c.Settings.ShowSummary
+	settings.ShowTOC = c.Settings.ShowTOC
+	settings.SplitRequirements = c.Settings.Split
+	settings.SplitRequiredOptional = c.Settings.SplitRequiredOptional
+	settings.ShowConstraintSource = c.Settings.VersionSource
+	settings.TrimDescription = c.Settings.TrimDescription
+	settings.TypeMaxLength = c.Settings.TypeMaxLength
+	settings.WrapAt = c.Settings.WrapAt
 
 	return settings, options
 }
 
+// confinedFiles returns the kinds of files which can only be read from within
+// the module fetched from a remote '--source', i.e. the ones not explicitly
+// set from CLI or a local config file (e.g. the default 'main.tf' header)
+func (c *Config) confinedFiles() []string {
+	files := []string{}
+	if c.Source == "" {
+		return files
+	}
+	kinds := [][2]string{
+		{"header-from", "header"},
+		{"footer-from", "footer"},
+		{"include-examples", "examples"},
+		{"default-values-file", "default values"},
+		{"output-values-from", "output values"},
+	}
+	for _, kind := range kinds {
+		if !c.flags.changed(kind[0]) {
+			files = append(files, kind[1])
+		}
+	}
+	return files
+}
+
+// formatters returns the set of formatters the module is rendered with,
+// the one of Config and the ones of its targets
+func (c *Config) formatters() map[string]bool {
+	formatters := map[string]bool{c.Formatter: true}
+	for _, t := range c.Targets {
+		formatters[t.Formatter] = true
+	}
+	return formatters
+}
+
 func contains(list []string, name string) bool {
 	for _, i := range list {
 		if i == name {
@@ -347,3 +1492,17 @@ func contains(list []string, name string) bool {
 	}
 	return false
 }
+
+// toggle adds 'name' to 'list' if 'enabled', otherwise removes it
+func toggle(list []string, name string, enabled bool) []string {
+	result := make([]string, 0, len(list)+1)
+	for _, i := range list {
+		if i != name {
+			result = append(result, i)
+		}
+	}
+	if enabled {
+		result = append(result, name)
+	}
+	return result
+}
@@ -0,0 +1,456 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionsVisibility(t *testing.T) {
+	all := []string{"data-sources", "footer", "header", "inputs", "modules", "outputs", "providers", "requirements", "resources"}
+	tests := []struct {
+		name     string
+		changed  []string
+		showAll  bool
+		hideAll  bool
+		show     []string
+		hide     []string
+		only     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "default",
+			showAll:  true,
+			expected: all,
+		},
+		{
+			name:     "show-all",
+			changed:  []string{"show-all"},
+			showAll:  true,
+			expected: all,
+		},
+		{
+			name:     "hide-all",
+			changed:  []string{"hide-all"},
+			showAll:  true,
+			hideAll:  true,
+			expected: []string{},
+		},
+		{
+			name:     "show-all=false",
+			changed:  []string{"show-all"},
+			showAll:  false,
+			expected: []string{},
+		},
+		{
+			name:     "show-all hide header",
+			changed:  []string{"show-all", "hide"},
+			showAll:  true,
+			hide:     []string{"header"},
+			expected: []string{"data-sources", "footer", "inputs", "modules", "outputs", "providers", "requirements", "resources"},
+		},
+		{
+			name:     "hide header",
+			changed:  []string{"hide"},
+			showAll:  true,
+			hide:     []string{"header"},
+			expected: []string{"data-sources", "footer", "inputs", "modules", "outputs", "providers", "requirements", "resources"},
+		},
+		{
+			name:     "hide-all show header",
+			changed:  []string{"hide-all", "show"},
+			showAll:  true,
+			hideAll:  true,
+			show:     []string{"header"},
+			expected: []string{"header"},
+		},
+		{
+			name:     "show header",
+			changed:  []string{"show"},
+			showAll:  true,
+			show:     []string{"header"},
+			expected: []string{"header"},
+		},
+		{
+			name:     "show-all show header",
+			changed:  []string{"show-all", "show"},
+			showAll:  true,
+			show:     []string{"header"},
+			expected: all,
+		},
+		{
+			name:     "hide-all hide header",
+			changed:  []string{"hide-all", "hide"},
+			showAll:  true,
+			hideAll:  true,
+			hide:     []string{"header"},
+			expected: []string{},
+		},
+		{
+			name:     "hide-all show inputs outputs hide header",
+			changed:  []string{"hide-all", "show", "hide"},
+			showAll:  true,
+			hideAll:  true,
+			show:     []string{"inputs", "outputs"},
+			hide:     []string{"header"},
+			expected: []string{"inputs", "outputs"},
+		},
+		{
+			name:    "show-all hide-all",
+			changed: []string{"show-all", "hide-all"},
+			showAll: true,
+			hideAll: true,
+			wantErr: true,
+		},
+		{
+			name:    "show header hide header",
+			changed: []string{"show", "hide"},
+			showAll: true,
+			show:    []string{"header"},
+			hide:    []string{"header"},
+			wantErr: true,
+		},
+		{
+			name:     "only inputs",
+			changed:  []string{"only"},
+			showAll:  true,
+			only:     "inputs",
+			expected: []string{"inputs"},
+		},
+		{
+			name:    "only invalid",
+			changed: []string{"only"},
+			showAll: true,
+			only:    "foo",
+			wantErr: true,
+		},
+		{
+			name:    "only inputs show outputs",
+			changed: []string{"only", "show"},
+			showAll: true,
+			show:    []string{"outputs"},
+			only:    "inputs",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			for _, flag := range tt.changed {
+				config.flags.set(flag, true)
+			}
+			config.Sections.ShowAll = tt.showAll
+			config.Sections.HideAll = tt.hideAll
+			config.Sections.Show = tt.show
+			config.Sections.Hide = tt.hide
+			config.Sections.Only = tt.only
+			config.normalize()
+
+			err := config.Sections.validate(config.flags)
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+
+			actual := []string{}
+			for _, section := range all {
+				if config.Sections.visibility(section) {
+					actual = append(actual, section)
+				}
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestSortByPosition(t *testing.T) {
+	tests := []struct {
+		name       string
+		changed    []string
+		by         sortmode
+		byPosition bool
+		expected   sortmode
+		wantErr    bool
+	}{
+		{
+			name:     "default",
+			by:       sortByName,
+			expected: sortByName,
+		},
+		{
+			name:       "sort-by-position",
+			changed:    []string{"sort-by-position"},
+			by:         sortByName,
+			byPosition: true,
+			expected:   sortByDeclaration,
+		},
+		{
+			name:     "sort-by position",
+			changed:  []string{"sort-by"},
+			by:       sortByPosition,
+			expected: sortByPosition,
+		},
+		{
+			name:     "sort-by declaration",
+			changed:  []string{"sort-by"},
+			by:       sortByDeclaration,
+			expected: sortByDeclaration,
+		},
+		{
+			name:    "sort-by position,declaration",
+			changed: []string{"sort-by"},
+			by:      "position,declaration",
+			wantErr: true,
+		},
+		{
+			name:    "sort-by position,name",
+			changed: []string{"sort-by"},
+			by:      "position,name",
+			wantErr: true,
+		},
+		{
+			name:       "sort-by-position sort-by type",
+			changed:    []string{"sort-by-position", "sort-by"},
+			by:         sortByType,
+			byPosition: true,
+			wantErr:    true,
+		},
+		{
+			name:       "sort-by-position sort-by-required",
+			changed:    []string{"sort-by-position", "sort-by-required"},
+			by:         sortByName,
+			byPosition: true,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			for _, flag := range tt.changed {
+				config.flags.set(flag, true)
+			}
+			config.Sort.By = tt.by
+			config.Sort.ByPosition = tt.byPosition
+			config.normalize()
+
+			err := config.Sort.validate(config.flags)
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.expected, config.Sort.inputs)
+			assert.Equal(tt.expected, config.Sort.outputs)
+			assert.Equal(tt.expected == sortByName, config.Sort.inputs.sortBy(true).Name)
+		})
+	}
+}
+
+func TestConfigFlags(t *testing.T) {
+	assert := assert.New(t)
+
+	changed := DefaultConfig()
+	changed.Formatter = "markdown table"
+	changed.flags.set("output-file", true)
+	changed.normalize()
+
+	// flags of one config don't leak into another one of the same process
+	other := DefaultConfig()
+	other.Formatter = "markdown table"
+	other.normalize()
+
+	// config created without DefaultConfig has no flag changed
+	empty := DefaultConfig()
+	empty.Formatter = "markdown table"
+	empty.flags = nil
+	empty.normalize()
+
+	assert.EqualError(changed.validate(), "value of '--output-file' can't be empty")
+	assert.Nil(other.validate())
+	assert.Nil(empty.validate())
+}
+
+func TestIndentHeadingBaseLevel(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		indent    int
+		heading   int
+	}{
+		{
+			name:      "json with indent 8",
+			formatter: "json",
+			indent:    8,
+			heading:   2,
+		},
+		{
+			name:      "yaml with indent 0",
+			formatter: "yaml",
+			indent:    0,
+			heading:   2,
+		},
+		{
+			name:      "markdown with indent 3",
+			formatter: "markdown table",
+			indent:    3,
+			heading:   3,
+		},
+		{
+			name:      "asciidoc with indent 4",
+			formatter: "asciidoc document",
+			indent:    4,
+			heading:   4,
+		},
+		{
+			name:      "markdown with indent 7",
+			formatter: "markdown table",
+			indent:    7,
+			heading:   2,
+		},
+		{
+			name:      "asciidoc with indent 0",
+			formatter: "asciidoc table",
+			indent:    0,
+			heading:   2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Settings.Indent = tt.indent
+			config.flags.set("indent", true)
+			config.normalize()
+
+			assert.Nil(config.validate())
+
+			settings, _ := config.extract()
+			assert.Equal(tt.indent, settings.IndentLevel)
+			assert.Equal(tt.heading, settings.HeadingBaseLevel)
+		})
+	}
+}
+
+func TestSectionsOrderAndTitles(t *testing.T) {
+	tests := []struct {
+		name    string
+		order   []string
+		titles  map[string]string
+		wantErr string
+	}{
+		{
+			name:   "sections ordered and titled",
+			order:  []string{"inputs", "header"},
+			titles: map[string]string{"inputs": "Variables"},
+		},
+		{
+			name:    "unknown section ordered",
+			order:   []string{"variables"},
+			wantErr: "'variables' is not a valid section of '--sections-order'",
+		},
+		{
+			name:    "meta ordered",
+			order:   []string{"meta", "inputs"},
+			wantErr: "'meta' is not a valid section of '--sections-order'",
+		},
+		{
+			name:    "meta titled",
+			titles:  map[string]string{"meta": "Generated"},
+			wantErr: "'meta' is not a valid section of '--title'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			if tt.order != nil {
+				config.Sections.Order = tt.order
+			}
+			if tt.titles != nil {
+				config.Sections.Titles = tt.titles
+			}
+			config.normalize()
+
+			err := config.validate()
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+		})
+	}
+}
+
+func TestBadgeStyles(t *testing.T) {
+	tests := []struct {
+		name    string
+		badge   string
+		boolean string
+		mark    string
+		wantErr string
+	}{
+		{
+			name:    "boolean style of text badges",
+			badge:   "text",
+			boolean: "check-cross",
+		},
+		{
+			name:  "sensitive mark of text badges",
+			badge: "text",
+			mark:  "🔒",
+		},
+		{
+			name:    "boolean style of emoji badges",
+			badge:   "emoji",
+			boolean: "true-false",
+			wantErr: "'--boolean-style' and '--badge-style emoji' can't be used together",
+		},
+		{
+			name:    "sensitive mark of shield badges",
+			badge:   "shield",
+			mark:    "🔒",
+			wantErr: "'--sensitive-mark' and '--badge-style shield' can't be used together",
+		},
+		{
+			name:    "sensitive mark with boolean style",
+			badge:   "text",
+			boolean: "check-cross",
+			mark:    "🔒",
+			wantErr: "'--sensitive-mark' and '--boolean-style check-cross' can't be used together",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Settings.BadgeStyle = tt.badge
+			if tt.boolean != "" {
+				config.Settings.BooleanStyle = tt.boolean
+			}
+			if tt.mark != "" {
+				config.Settings.SensitiveMark = tt.mark
+				config.flags.set("sensitive-mark", true)
+			}
+			config.normalize()
+
+			err := config.validate()
+			if tt.wantErr != "" {
+				assert.EqualError(err, tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// envPrefix is the prefix of environment variables which options can be
+// read from, followed by upper-cased flag name (e.g. TERRAFORM_DOCS_SORT_BY)
+const envPrefix = "TERRAFORM_DOCS_"
+
+// envName returns the name of environment variable corresponding to 'flag'
+func envName(flag string) string {
+	return envPrefix + strings.ToUpper(strings.Replace(flag, "-", "_", -1))
+}
+
+// readEnv sets the options, which can be set in config file too, from their
+// corresponding environment variable if not explicitly set from CLI, and marks
+// them as explicitly changed. It must be called before readConfig, so any value
+// of config file overrides them.
+func readEnv(cmd *cobra.Command, flags *flagset) error {
+	for _, fk := range flagkeys {
+		// neither the flag nor its deprecated negation (e.g. '--no-sort') is
+		// overridden, explicitly set from CLI
+		if flags.changed(fk.flag) || flags.changed("no-"+fk.flag) || cmd.Flags().Lookup(fk.flag) == nil {
+			continue
+		}
+		value, ok := os.LookupEnv(envName(fk.flag))
+		if !ok {
+			continue
+		}
+		if err := cmd.Flags().Set(fk.flag, value); err != nil {
+			return fmt.Errorf("value of '%s' is invalid: %v", envName(fk.flag), err)
+		}
+		flags.setFromEnv(fk.flag)
+	}
+	return nil
+}
+
+// FormatterArgs prepends the formatter read from TERRAFORM_DOCS_FORMATTER
+// (e.g. "markdown table") to 'args', only if they don't specify any command
+// of 'root' and the environment variable is set.
+func FormatterArgs(root *cobra.Command, args []string) []string {
+	formatter := strings.Fields(os.Getenv(envName("formatter")))
+	if len(formatter) == 0 || len(args) == 0 {
+		return args
+	}
+	// root command is found with an error, if a path is passed to it instead
+	// of a command (e.g. 'terraform-docs /path/to/module')
+	if cmd, _, err := root.Find(args); err == nil || cmd != root {
+		return args
+	}
+	return append(formatter, args...)
+}
@@ -0,0 +1,299 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		file    string
+		cli     map[string]string
+		sortBy  sortmode
+		indent  int
+		errText string
+	}{
+		{
+			name:   "defaults",
+			sortBy: "name",
+			indent: 2,
+		},
+		{
+			name: "environment over defaults",
+			env: map[string]string{
+				"TERRAFORM_DOCS_SORT_BY": "required",
+				"TERRAFORM_DOCS_INDENT":  "3",
+			},
+			sortBy: "required",
+			indent: 3,
+		},
+		{
+			name: "config file over environment",
+			env: map[string]string{
+				"TERRAFORM_DOCS_SORT_BY": "required",
+				"TERRAFORM_DOCS_INDENT":  "3",
+			},
+			file:   "sort:\n  by: type\n",
+			sortBy: "type",
+			indent: 3,
+		},
+		{
+			name: "flags over config file and environment",
+			env: map[string]string{
+				"TERRAFORM_DOCS_SORT_BY": "required",
+				"TERRAFORM_DOCS_INDENT":  "3",
+			},
+			file: "sort:\n  by: type\n",
+			cli: map[string]string{
+				"sort-by": "name",
+				"indent":  "4",
+			},
+			sortBy: "name",
+			indent: 4,
+		},
+		{
+			name: "malformed value",
+			env: map[string]string{
+				"TERRAFORM_DOCS_INDENT": "four",
+			},
+			errText: "value of 'TERRAFORM_DOCS_INDENT' is invalid",
+		},
+		{
+			name: "invalid value",
+			env: map[string]string{
+				"TERRAFORM_DOCS_SORT_BY": "size",
+			},
+			errText: "value of '--sort-by' must be one of",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			dir, err := ioutil.TempDir("", "terraform-docs-env")
+			assert.Nil(err)
+			defer os.RemoveAll(dir)
+			if tt.file != "" {
+				assert.Nil(ioutil.WriteFile(filepath.Join(dir, ".terraform-docs.yml"), []byte(tt.file), 0644))
+			}
+
+			config := DefaultConfig()
+			root := &cobra.Command{Use: "terraform-docs"}
+			cmd := &cobra.Command{Use: "markdown"}
+			root.AddCommand(cmd)
+			cmd.Flags().StringVar((*string)(&config.Sort.By), "sort-by", "name", "")
+			cmd.Flags().IntVar(&config.Settings.Indent, "indent", 2, "")
+			for name, value := range tt.cli {
+				assert.Nil(cmd.Flags().Set(name, value))
+			}
+
+			err = PreRunEFunc(config)(cmd, []string{dir})
+			if tt.errText != "" {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), tt.errText)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.sortBy, config.Sort.By)
+			assert.Equal(tt.indent, config.Settings.Indent)
+		})
+	}
+}
+
+func TestFormatterArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		args      []string
+		expected  []string
+	}{
+		{
+			name:      "formatter not set",
+			formatter: "",
+			args:      []string{"/path/to/module"},
+			expected:  []string{"/path/to/module"},
+		},
+		{
+			name:      "formatter prepended to path",
+			formatter: "markdown table",
+			args:      []string{"/path/to/module"},
+			expected:  []string{"markdown", "table", "/path/to/module"},
+		},
+		{
+			name:      "formatter prepended to flags and path",
+			formatter: "markdown",
+			args:      []string{"--sort-by", "required", "/path/to/module"},
+			expected:  []string{"markdown", "--sort-by", "required", "/path/to/module"},
+		},
+		{
+			name:      "formatter of command kept",
+			formatter: "markdown table",
+			args:      []string{"json", "/path/to/module"},
+			expected:  []string{"json", "/path/to/module"},
+		},
+		{
+			name:      "no arguments",
+			formatter: "markdown table",
+			args:      []string{},
+			expected:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			t.Setenv("TERRAFORM_DOCS_FORMATTER", tt.formatter)
+
+			root := &cobra.Command{Use: "terraform-docs"}
+			root.PersistentFlags().String("sort-by", "name", "")
+			markdown := &cobra.Command{Use: "markdown"}
+			markdown.AddCommand(&cobra.Command{Use: "table"})
+			root.AddCommand(markdown, &cobra.Command{Use: "json"})
+
+			assert.Equal(tt.expected, FormatterArgs(root, tt.args))
+		})
+	}
+}
+
+func TestReadEnvSettings(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		file     string
+		cli      map[string]string
+		expected func(*assert.Assertions, *Config)
+	}{
+		{
+			name: "defaults",
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.True(config.Settings.Required)
+				assert.True(config.Sort.Enabled)
+				assert.True(config.Settings.Escape)
+				assert.Equal("markdown", config.Settings.EscapeMode)
+				assert.True(config.Settings.Sensitive)
+				assert.Equal(2, config.Settings.HeadingBaseLevel)
+			},
+		},
+		{
+			name: "boolean settings disabled from environment",
+			env: map[string]string{
+				"TERRAFORM_DOCS_REQUIRED":  "false",
+				"TERRAFORM_DOCS_SORT":      "false",
+				"TERRAFORM_DOCS_ESCAPE":    "false",
+				"TERRAFORM_DOCS_COLOR":     "false",
+				"TERRAFORM_DOCS_SENSITIVE": "false",
+			},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.False(config.Settings.Required)
+				assert.False(config.Sort.Enabled)
+				assert.False(config.Settings.Escape)
+				assert.Equal("none", config.Settings.EscapeMode)
+				assert.False(config.Settings.Color)
+				assert.False(config.Settings.Sensitive)
+			},
+		},
+		{
+			name: "color enabled from environment",
+			env: map[string]string{
+				"TERRAFORM_DOCS_COLOR": "true",
+			},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.True(config.Settings.Color)
+			},
+		},
+		{
+			name: "boolean settings of environment overridden by config file",
+			env: map[string]string{
+				"TERRAFORM_DOCS_REQUIRED": "false",
+				"TERRAFORM_DOCS_SORT":     "false",
+			},
+			file: "sort:\n  enabled: true\nsettings:\n  required: true\n",
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.True(config.Settings.Required)
+				assert.True(config.Sort.Enabled)
+			},
+		},
+		{
+			name: "boolean settings of environment overridden by deprecated flags",
+			env: map[string]string{
+				"TERRAFORM_DOCS_REQUIRED": "true",
+				"TERRAFORM_DOCS_SORT":     "true",
+			},
+			cli: map[string]string{
+				"no-required": "true",
+				"no-sort":     "true",
+			},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.False(config.Settings.Required)
+				assert.False(config.Sort.Enabled)
+			},
+		},
+		{
+			name: "indent from environment as heading level",
+			env: map[string]string{
+				"TERRAFORM_DOCS_INDENT": "4",
+			},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal(4, config.Settings.Indent)
+				assert.Equal(4, config.Settings.HeadingBaseLevel)
+			},
+		},
+		{
+			name: "heading level from environment over indent",
+			env: map[string]string{
+				"TERRAFORM_DOCS_INDENT":             "4",
+				"TERRAFORM_DOCS_HEADING_BASE_LEVEL": "3",
+			},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal(3, config.Settings.HeadingBaseLevel)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+
+			dir, err := ioutil.TempDir("", "terraform-docs-env")
+			assert.Nil(err)
+			defer os.RemoveAll(dir)
+			if tt.file != "" {
+				assert.Nil(ioutil.WriteFile(filepath.Join(dir, ".terraform-docs.yml"), []byte(tt.file), 0644))
+			}
+
+			config := DefaultConfig()
+			root := &cobra.Command{Use: "terraform-docs"}
+			cmd := &cobra.Command{Use: "markdown"}
+			root.AddCommand(cmd)
+			cmd.Flags().BoolVar(&config.Sort.Enabled, "sort", true, "")
+			cmd.Flags().BoolVar(&config.Sort.Deprecated.NoSort, "no-sort", false, "")
+			cmd.Flags().BoolVar(&config.Settings.Required, "required", true, "")
+			cmd.Flags().BoolVar(&config.Settings.Deprecated.NoRequired, "no-required", false, "")
+			cmd.Flags().BoolVar(&config.Settings.Escape, "escape", true, "")
+			cmd.Flags().BoolVar(&config.Settings.Color, "color", true, "")
+			cmd.Flags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "")
+			cmd.Flags().IntVar(&config.Settings.Indent, "indent", 2, "")
+			cmd.Flags().IntVar(&config.Settings.HeadingBaseLevel, "heading-base-level", 2, "")
+			for name, value := range tt.cli {
+				assert.Nil(cmd.Flags().Set(name, value))
+			}
+
+			assert.Nil(PreRunEFunc(config)(cmd, []string{dir}))
+			tt.expected(assert, config)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// checkDescriptions returns an error listing the inputs and outputs of
+// module at 'path' which don't have any description, nil if all of them do
+func checkDescriptions(path string, tfmodule *tfconf.Module) error {
+	missing := []string{}
+	for _, i := range tfmodule.Inputs {
+		if strings.TrimSpace(string(i.Description)) == "" {
+			missing = append(missing, fmt.Sprintf("input '%s'", i.Name))
+		}
+	}
+	for _, o := range tfmodule.Outputs {
+		if strings.TrimSpace(string(o.Description)) == "" {
+			missing = append(missing, fmt.Sprintf("output '%s'", o.Name))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s has %d item(s) without description:\n  %s", path, len(missing), strings.Join(missing, "\n  "))
+}
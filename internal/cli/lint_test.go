@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/types"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+func TestCheckDescriptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		inputs   []*tfconf.Input
+		outputs  []*tfconf.Output
+		expected string
+	}{
+		{
+			name: "all described",
+			inputs: []*tfconf.Input{
+				{Name: "foo", Description: types.String("foo input")},
+			},
+			outputs: []*tfconf.Output{
+				{Name: "bar", Description: types.String("bar output")},
+			},
+		},
+		{
+			name: "empty module",
+		},
+		{
+			name: "missing and blank",
+			inputs: []*tfconf.Input{
+				{Name: "foo", Description: types.String("")},
+				{Name: "baz", Description: types.String("baz input")},
+			},
+			outputs: []*tfconf.Output{
+				{Name: "bar", Description: types.String("  ")},
+			},
+			expected: "mod has 2 item(s) without description:\n  input 'foo'\n  output 'bar'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			err := checkDescriptions("mod", &tfconf.Module{Inputs: tt.inputs, Outputs: tt.outputs})
+			if tt.expected == "" {
+				assert.Nil(err)
+				return
+			}
+			assert.EqualError(err, tt.expected)
+		})
+	}
+}
@@ -0,0 +1,361 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// list of flag names and their corresponding keys in config file
+var flagkeys = []struct {
+	flag string
+	key  string
+}{
+	{"header-from", "header-from"},
+	{"footer-from", "footer-from"},
+	{"include-examples", "include-examples"},
+	{"default-values-file", "default-values-file"},
+	{"show", "sections.show"},
+	{"hide", "sections.hide"},
+	{"show-all", "sections.show-all"},
+	{"hide-all", "sections.hide-all"},
+	{"only", "sections.only"},
+	{"sections-order", "sections.order"},
+	{"title", "sections.titles"},
+	{"include-inputs", "filter.include-inputs"},
+	{"exclude-inputs", "filter.exclude-inputs"},
+	{"include-outputs", "filter.include-outputs"},
+	{"exclude-outputs", "filter.exclude-outputs"},
+	{"output-file", "output.file"},
+	{"output-mode", "output.mode"},
+	{"check", "output.check"},
+	{"target", "targets"},
+	{"output-template", "output-template"},
+	{"readme-template", "readme-template"},
+	{"post-process", "post-process"},
+	{"output-values", "output-values.enabled"},
+	{"output-values-from", "output-values.from"},
+	{"quiet", "quiet"},
+	{"strict", "strict"},
+	{"fail-on-missing-description", "fail-on-missing-description"},
+	{"catalog", "catalog"},
+	{"diff", "diff"},
+	{"recursive", "recursive.enabled"},
+	{"recursive-path", "recursive.path"},
+	{"parallelism", "parallelism"},
+	{"sort", "sort.enabled"},
+	{"sort-by", "sort.by"},
+	{"sort-inputs-by", "sort.inputs-by"},
+	{"sort-outputs-by", "sort.outputs-by"},
+	{"sort-by-position", "sort.by-position"},
+	{"anchor", "settings.anchor"},
+	{"anchor-style", "settings.anchor-style"},
+	{"badge-style", "settings.badge-style"},
+	{"boolean-style", "settings.boolean-style"},
+	{"collapse-descriptions", "settings.collapse-descriptions"},
+	{"collapse-threshold", "settings.collapse-threshold"},
+	{"color", "settings.color"},
+	{"compact", "settings.compact"},
+	{"defaults-as-hcl", "settings.defaults-as-hcl"},
+	{"depends-on", "settings.depends-on"},
+	{"escape", "settings.escape"},
+	{"escape-mode", "settings.escape-mode"},
+	{"extract-examples", "settings.extract-examples"},
+	{"format-complex-types", "settings.format-complex-types"},
+	{"group-by-file", "settings.group-by-file"},
+	{"heading-base-level", "settings.heading-base-level"},
+	{"hide-columns", "settings.hide-columns"},
+	{"hide-empty", "settings.hide-empty"},
+	{"indent", "settings.indent"},
+	{"input-values", "settings.input-values"},
+	{"inputs-as-subsections", "settings.inputs-as-subsections"},
+	{"link-resources", "settings.link-resources"},
+	{"lockfile", "settings.lockfile"},
+	{"max-line-length", "settings.max-line-length"},
+	{"meta-timestamp", "settings.meta-timestamp"},
+	{"no-empty-defaults", "settings.no-empty-defaults"},
+	{"normalize-module-sources", "settings.normalize-module-sources"},
+	{"normalize-types", "settings.normalize-types"},
+	{"nullable", "settings.nullable"},
+	{"partition-sensitive-outputs", "settings.partition-sensitive-outputs"},
+	{"placeholder", "settings.placeholder"},
+	{"provider-namespace", "settings.provider-namespace"},
+	{"raw-descriptions", "settings.raw-descriptions"},
+	{"read-comments", "settings.read-comments"},
+	{"required", "settings.required"},
+	{"sensitive", "settings.sensitive"},
+	{"sensitive-alerts", "settings.sensitive-alerts"},
+	{"sensitive-mark", "settings.sensitive-mark"},
+	{"show-summary", "settings.show-summary"},
+	{"show-toc", "settings.show-toc"},
+	{"split-requirements", "settings.split-requirements"},
+	{"split-required-optional", "settings.split-required-optional"},
+	{"terraform-requirement", "settings.terraform-requirement"},
+	{"trim-description", "settings.trim-description"},
+	{"type-max-length", "settings.type-max-length"},
+	{"validation", "settings.validation"},
+	{"version-constraint", "settings.version-constraint"},
+	{"wrap-at", "settings.wrap-at"},
+}
+
+// cfgreader reads a config file and merges its values into Config. Any
+// value which is explicitly set from CLI takes precedence over the file.
+type cfgreader struct {
+	file       string
+	config     *Config
+	fromSource bool // file is in the repository fetched from '--source'
+}
+
+func (c *cfgreader) exist() (bool, error) {
+	info, err := os.Stat(c.file)
+	if os.IsNotExist(err) {
+		return false, fmt.Errorf("config file %s not found", c.file)
+	}
+	if err != nil {
+		return false, err
+	}
+	if info.IsDir() {
+		return false, fmt.Errorf("config file %s is a directory", c.file)
+	}
+	return true, nil
+}
+
+func (c *cfgreader) parse() error {
+	content, err := ioutil.ReadFile(c.file)
+	if err != nil {
+		return err
+	}
+
+	file := DefaultConfig()
+	if err := yaml.Unmarshal(content, file); err != nil {
+		return fmt.Errorf("caught error while reading the config file at %s: %v", c.file, err)
+	}
+	var keys map[string]interface{}
+	if err := yaml.Unmarshal(content, &keys); err != nil {
+		return fmt.Errorf("caught error while reading the config file at %s: %v", c.file, err)
+	}
+
+	// values read from environment variables are overridden by the file
+	explicit := func(name string) bool {
+		return c.config.flags.changed(name) && !c.config.flags.fromEnv(name)
+	}
+
+	// '--show-all' and '--hide-all' are the same base of sections, the one
+	// explicitly set from CLI takes precedence over both of them in file
+	base := explicit("show-all") || explicit("hide-all")
+
+	for _, fk := range flagkeys {
+		if explicit(fk.flag) || !isSet(keys, fk.key) {
+			continue
+		}
+		if base && (fk.flag == "show-all" || fk.flag == "hide-all") {
+			continue
+		}
+		c.override(fk.flag, file)
+
+		// from now on the value is considered as explicitly set
+		c.config.flags.set(fk.flag, true)
+		if c.fromSource {
+			c.config.flags.setFromSource(fk.flag)
+		}
+	}
+	return nil
+}
+
+// override the value corresponding to 'flag' in Config with the one read from file
+func (c *cfgreader) override(flag string, file *Config) {
+	switch flag {
+	case "header-from":
+		c.config.HeaderFrom = file.HeaderFrom
+	case "footer-from":
+		c.config.FooterFrom = file.FooterFrom
+	case "include-examples":
+		c.config.IncludeExamples = file.IncludeExamples
+	case "default-values-file":
+		c.config.DefaultValues = file.DefaultValues
+	case "show":
+		c.config.Sections.Show = file.Sections.Show
+	case "hide":
+		c.config.Sections.Hide = file.Sections.Hide
+	case "show-all":
+		c.config.Sections.ShowAll = file.Sections.ShowAll
+	case "hide-all":
+		c.config.Sections.HideAll = file.Sections.HideAll
+	case "only":
+		c.config.Sections.Only = file.Sections.Only
+	case "sections-order":
+		c.config.Sections.Order = file.Sections.Order
+	case "title":
+		c.config.Sections.Titles = file.Sections.Titles
+	case "include-inputs":
+		c.config.Filter.IncludeInputs = file.Filter.IncludeInputs
+	case "exclude-inputs":
+		c.config.Filter.ExcludeInputs = file.Filter.ExcludeInputs
+	case "include-outputs":
+		c.config.Filter.IncludeOutputs = file.Filter.IncludeOutputs
+	case "exclude-outputs":
+		c.config.Filter.ExcludeOutputs = file.Filter.ExcludeOutputs
+	case "output-file":
+		c.config.Output.File = file.Output.File
+	case "output-mode":
+		c.config.Output.Mode = file.Output.Mode
+	case "check":
+		c.config.Output.Check = file.Output.Check
+	case "target":
+		c.config.Targets = file.Targets
+	case "output-template":
+		c.config.OutputTemplate = file.OutputTemplate
+	case "readme-template":
+		c.config.ReadmeTemplate = file.ReadmeTemplate
+	case "post-process":
+		c.config.PostProcess = file.PostProcess
+	case "output-values":
+		c.config.OutputValues.Enabled = file.OutputValues.Enabled
+	case "output-values-from":
+		c.config.OutputValues.From = file.OutputValues.From
+	case "quiet":
+		c.config.Quiet = file.Quiet
+	case "strict":
+		c.config.Strict = file.Strict
+	case "fail-on-missing-description":
+		c.config.FailOnMissingDescription = file.FailOnMissingDescription
+	case "catalog":
+		c.config.Catalog = file.Catalog
+	case "diff":
+		c.config.Diff = file.Diff
+	case "recursive":
+		c.config.Recursive.Enabled = file.Recursive.Enabled
+	case "parallelism":
+		c.config.Parallelism = file.Parallelism
+	case "recursive-path":
+		c.config.Recursive.Path = file.Recursive.Path
+	case "sort":
+		c.config.Sort.Enabled = file.Sort.Enabled
+	case "sort-by":
+		c.config.Sort.By = file.Sort.By
+	case "sort-inputs-by":
+		c.config.Sort.InputsBy = file.Sort.InputsBy
+	case "sort-outputs-by":
+		c.config.Sort.OutputsBy = file.Sort.OutputsBy
+	case "sort-by-position":
+		c.config.Sort.ByPosition = file.Sort.ByPosition
+	case "anchor":
+		c.config.Settings.Anchor = file.Settings.Anchor
+	case "anchor-style":
+		c.config.Settings.AnchorStyle = file.Settings.AnchorStyle
+	case "badge-style":
+		c.config.Settings.BadgeStyle = file.Settings.BadgeStyle
+	case "boolean-style":
+		c.config.Settings.BooleanStyle = file.Settings.BooleanStyle
+	case "collapse-descriptions":
+		c.config.Settings.Collapse = file.Settings.Collapse
+	case "collapse-threshold":
+		c.config.Settings.CollapseLength = file.Settings.CollapseLength
+	case "color":
+		c.config.Settings.Color = file.Settings.Color
+	case "compact":
+		c.config.Settings.Compact = file.Settings.Compact
+	case "defaults-as-hcl":
+		c.config.Settings.DefaultsAsHCL = file.Settings.DefaultsAsHCL
+	case "depends-on":
+		c.config.Settings.DependsOn = file.Settings.DependsOn
+	case "escape":
+		c.config.Settings.Escape = file.Settings.Escape
+	case "escape-mode":
+		c.config.Settings.EscapeMode = file.Settings.EscapeMode
+	case "extract-examples":
+		c.config.Settings.ExtractExamples = file.Settings.ExtractExamples
+	case "format-complex-types":
+		c.config.Settings.FormatTypes = file.Settings.FormatTypes
+	case "group-by-file":
+		c.config.Settings.GroupByFile = file.Settings.GroupByFile
+	case "heading-base-level":
+		c.config.Settings.HeadingBaseLevel = file.Settings.HeadingBaseLevel
+	case "hide-columns":
+		c.config.Settings.HideColumns = file.Settings.HideColumns
+	case "hide-empty":
+		c.config.Settings.HideEmpty = file.Settings.HideEmpty
+	case "indent":
+		c.config.Settings.Indent = file.Settings.Indent
+	case "input-values":
+		c.config.Settings.InputValues = file.Settings.InputValues
+	case "inputs-as-subsections":
+		c.config.Settings.InputsAsSubsections = file.Settings.InputsAsSubsections
+	case "link-resources":
+		c.config.Settings.LinkResources = file.Settings.LinkResources
+	case "lockfile":
+		c.config.Settings.Lockfile = file.Settings.Lockfile
+	case "max-line-length":
+		c.config.Settings.MaxLineLength = file.Settings.MaxLineLength
+	case "meta-timestamp":
+		c.config.Settings.MetaTimestamp = file.Settings.MetaTimestamp
+	case "no-empty-defaults":
+		c.config.Settings.NoEmptyDefaults = file.Settings.NoEmptyDefaults
+	case "normalize-module-sources":
+		c.config.Settings.NormalizeModuleSources = file.Settings.NormalizeModuleSources
+	case "normalize-types":
+		c.config.Settings.NormalizeTypes = file.Settings.NormalizeTypes
+	case "nullable":
+		c.config.Settings.Nullable = file.Settings.Nullable
+	case "partition-sensitive-outputs":
+		c.config.Settings.PartitionSensitive = file.Settings.PartitionSensitive
+	case "placeholder":
+		c.config.Settings.Placeholder = file.Settings.Placeholder
+	case "provider-namespace":
+		c.config.Settings.ProviderNamespace = file.Settings.ProviderNamespace
+	case "raw-descriptions":
+		c.config.Settings.RawDescriptions = file.Settings.RawDescriptions
+	case "read-comments":
+		c.config.Settings.ReadComments = file.Settings.ReadComments
+	case "required":
+		c.config.Settings.Required = file.Settings.Required
+	case "sensitive":
+		c.config.Settings.Sensitive = file.Settings.Sensitive
+	case "sensitive-alerts":
+		c.config.Settings.SensitiveAlerts = file.Settings.SensitiveAlerts
+	case "sensitive-mark":
+		c.config.Settings.SensitiveMark = file.Settings.SensitiveMark
+	case "show-summary":
+		c.config.Settings.ShowSummary = file.Settings.ShowSummary
+	case "show-toc":
+		c.config.Settings.ShowTOC = file.Settings.ShowTOC
+	case "split-requirements":
+		c.config.Settings.Split = file.Settings.Split
+	case "split-required-optional":
+		c.config.Settings.SplitRequiredOptional = file.Settings.SplitRequiredOptional
+	case "terraform-requirement":
+		c.config.Settings.TerraformRequirement = file.Settings.TerraformRequirement
+	case "trim-description":
+		c.config.Settings.TrimDescription = file.Settings.TrimDescription
+	case "type-max-length":
+		c.config.Settings.TypeMaxLength = file.Settings.TypeMaxLength
+	case "validation":
+		c.config.Settings.Validation = file.Settings.Validation
+	case "version-constraint":
+		c.config.Settings.VersionSource = file.Settings.VersionSource
+	case "wrap-at":
+		c.config.Settings.WrapAt = file.Settings.WrapAt
+	}
+}
+
+// isSet indicates if the dot-separated 'key' (e.g. 'sort.by.required')
+// is present in the parsed config file
+func isSet(keys map[string]interface{}, key string) bool {
+	segments := strings.Split(key, ".")
+	for i, segment := range segments {
+		value, ok := keys[segment]
+		if !ok {
+			return false
+		}
+		if i == len(segments)-1 {
+			return true
+		}
+		if keys, ok = value.(map[string]interface{}); !ok {
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConfigReaderPrecedence(t *testing.T) {
+	content := `footer-from: footer.md
+sort:
+  by: required
+settings:
+  indent: 4
+`
+	tests := []struct {
+		name     string
+		cli      func(*Config)
+		expected func(*assert.Assertions, *Config)
+	}{
+		{
+			name: "values inherited from file",
+			cli:  func(config *Config) {},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal("footer.md", config.FooterFrom)
+				assert.Equal(sortmode("required"), config.Sort.By)
+				assert.Equal(4, config.Settings.Indent)
+				assert.True(config.flags.changed("footer-from"))
+				assert.True(config.flags.changed("sort-by"))
+				assert.True(config.flags.changed("indent"))
+			},
+		},
+		{
+			name: "values overridden by flags",
+			cli: func(config *Config) {
+				config.Sort.By = "type"
+				config.flags.set("sort-by", true)
+				config.Settings.Indent = 3
+				config.flags.set("indent", true)
+			},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal("footer.md", config.FooterFrom)
+				assert.Equal(sortmode("type"), config.Sort.By)
+				assert.Equal(3, config.Settings.Indent)
+				assert.True(config.flags.changed("sort-by"))
+				assert.True(config.flags.changed("indent"))
+			},
+		},
+		{
+			name: "defaults of values missing from file",
+			cli:  func(config *Config) {},
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal(pathlist{"main.tf"}, config.HeaderFrom)
+				assert.Equal("inject", config.Output.Mode)
+				assert.Equal(2, config.Settings.HeadingBaseLevel)
+				assert.False(config.flags.changed("header-from"))
+				assert.False(config.flags.changed("output-mode"))
+				assert.False(config.flags.changed("heading-base-level"))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir, err := ioutil.TempDir("", "terraform-docs-config")
+			assert.Nil(err)
+			defer os.RemoveAll(dir)
+
+			file := filepath.Join(dir, ".terraform-docs.yml")
+			assert.Nil(ioutil.WriteFile(file, []byte(content), 0644))
+
+			config := DefaultConfig()
+			tt.cli(config)
+
+			reader := &cfgreader{file: file, config: config}
+			assert.Nil(reader.parse())
+
+			tt.expected(assert, config)
+		})
+	}
+}
+
+func TestIsSet(t *testing.T) {
+	content := `header-from: main.tf
+sort:
+  by: required
+settings:
+  indent: 0
+  color:
+`
+	tests := []struct {
+		name     string
+		key      string
+		expected bool
+	}{
+		{
+			name:     "top level key",
+			key:      "header-from",
+			expected: true,
+		},
+		{
+			name:     "nested key",
+			key:      "sort.by",
+			expected: true,
+		},
+		{
+			name:     "nested key with zero value",
+			key:      "settings.indent",
+			expected: true,
+		},
+		{
+			name:     "nested key without value",
+			key:      "settings.color",
+			expected: true,
+		},
+		{
+			name:     "missing top level key",
+			key:      "footer-from",
+			expected: false,
+		},
+		{
+			name:     "missing nested key",
+			key:      "sort.enabled",
+			expected: false,
+		},
+		{
+			name:     "key nested in scalar value",
+			key:      "header-from.file",
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			var keys map[string]interface{}
+			assert.Nil(yaml.Unmarshal([]byte(content), &keys))
+
+			assert.Equal(tt.expected, isSet(keys, tt.key))
+		})
+	}
+}
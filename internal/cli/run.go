@@ -1,25 +1,76 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/segmentio/terraform-docs/internal/format"
 	"github.com/segmentio/terraform-docs/internal/module"
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
 
 // PreRunEFunc returns actual 'cobra.Command#PreRunE' function
 // for 'formatter' commands. This functions reads and normalizes
 // flags and arguments passed through CLI execution.
 func PreRunEFunc(config *Config) func(*cobra.Command, []string) error {
-	return func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) (err error) {
+		config.flags = newFlagset()
 		cmd.Flags().VisitAll(func(f *pflag.Flag) {
-			changedfs[f.Name] = f.Changed
+			config.flags.set(f.Name, f.Changed)
+			if message, ok := deprecation(f); ok && f.Changed {
+				config.flags.deprecate(f.Name, message)
+			}
 		})
 
-		config.normalize(cmd.CommandPath())
+		if err := readEnv(cmd, config.flags); err != nil {
+			return err
+		}
+
+		var path string
+		if config.Source != "" {
+			if path, err = config.fetchSource(); err != nil {
+				return err
+			}
+			defer func() {
+				if err != nil {
+					config.cleanupSource()
+				}
+			}()
+		} else {
+			path = args[0]
+		}
+
+		if err := readConfig(config, path); err != nil {
+			return err
+		}
+
+		config.Formatter = strings.Replace(cmd.CommandPath(), "terraform-docs ", "", -1)
+		config.normalize()
+
+		// deprecation, notices of the deprecated flags used go to stderr, so
+		// they never end up in the output, and are omitted in quiet mode
+		if !config.Quiet {
+			for _, d := range config.flags.deprecations() {
+				fmt.Fprintf(os.Stderr, "Flag --%s has been deprecated, %s\n", d[0], d[1])
+			}
+		}
+
+		// colors are only meant for a terminal, unless explicitly asked for
+		if !config.flags.changed("color") && !config.flags.changed("no-color") && (config.Output.File != "" || !isTerminal(os.Stdout)) {
+			config.Settings.Color = false
+		}
 
 		if err := config.validate(); err != nil {
 			return err
@@ -29,34 +80,507 @@ func PreRunEFunc(config *Config) func(*cobra.Command, []string) error {
 	}
 }
 
+// ArgsFunc returns actual 'cobra.Command#Args' function for 'formatter'
+// commands. PATH of the module is required, unless it's read from the
+// remote '--source' instead.
+func ArgsFunc(config *Config) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if config.Source == "" {
+			return cobra.ExactArgs(1)(cmd, args)
+		}
+		if len(args) != 0 {
+			return fmt.Errorf("'--source' and PATH can't be used together")
+		}
+		return nil
+	}
+}
+
 // RunEFunc returns actual 'cobra.Command#RunE' function for
 // 'formatter' commands. This functions extract print.Settings
 // and module.Options from generated and normalized Config and
 // initializes required print.Format instance and executes it.
 func RunEFunc(config *Config) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		settings, options := config.extract()
+		defer config.cleanupSource()
 
-		printer, err := format.Factory(config.Formatter, settings)
-		if err != nil {
+		root := config.sourcePath
+		if config.Source == "" {
+			root = args[0]
+		}
+		if config.Catalog {
+			return catalog(config, root)
+		}
+		if config.Diff != "" {
+			return compare(config, root)
+		}
+		paths := []string{root}
+
+		if config.Recursive.Enabled {
+			submodules, err := findSubmodules(filepath.Join(root, config.Recursive.Path))
+			if err != nil {
+				return err
+			}
+			paths = append(paths, submodules...)
+		}
+		if config.Formatter == "jsonl" {
+			return stream(os.Stdout, config, root, paths)
+		}
+
+		// modules are rendered concurrently, but printed out or written
+		// in their order, up to the first one which failed
+		docs := make([]*document, len(paths))
+		errs := parallelize(paths, config.Parallelism, func(i int, path string) (err error) {
+			docs[i], err = prepare(config, path)
 			return err
+		})
+		for i := range paths {
+			if errs[i] != nil {
+				return errs[i]
+			}
+			if err := docs[i].emit(config); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// Generate normalizes and validates a copy of the provided Config, which is
+// left untouched, and returns the rendered output of module at 'path' with
+// the formatter of Config (e.g. "markdown table"). Nothing gets written into
+// Config.Output.File, the output is only returned to the caller. If
+// Config.Source is set, module is read from it instead and 'path' is ignored.
+// Warnings of parsing the module are written into stderr, unless Config.Quiet
+// is set.
+func Generate(config *Config, path string) (string, error) {
+	config = config.clone()
+	config.normalize()
+
+	if err := config.validate(); err != nil {
+		return "", err
+	}
+
+	if config.Source != "" {
+		var err error
+		if path, err = config.fetchSource(); err != nil {
+			return "", err
+		}
+		defer config.cleanupSource()
+	}
+
+	return render(config, path)
+}
+
+// render the output of module at 'path' with the formatter of Config
+func render(config *Config, path string) (string, error) {
+	settings, tfmodule, err := load(config, path)
+	if err != nil {
+		return "", err
+	}
+	return renderOutput(config, path, settings, tfmodule)
+}
+
+// load the module at 'path' with the options extracted from Config, and
+// returns it along with the extracted print.Settings. Warnings of parsing
+// the module go to stderr, so they never end up in the output, and are
+// omitted in quiet mode.
+func load(config *Config, path string) (*print.Settings, *tfconf.Module, error) {
+	settings, options := config.extract()
+
+	options.Path = path
+
+	tfmodule, warnings, err := module.LoadWithWarnings(options)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !config.Quiet {
+		for _, warning := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", warning.Summary, warning.Detail)
 		}
+	}
+	return settings, tfmodule, nil
+}
+
+// renderOutput renders the already loaded 'tfmodule' of 'path' with the
+// formatter of Config, into the README template of the module if it's set
+func renderOutput(config *Config, path string, settings *print.Settings, tfmodule *tfconf.Module) (string, error) {
+	if config.ReadmeTemplate == "" {
+		return renderWith(config.Formatter, settings, tfmodule)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(path, config.ReadmeTemplate))
+	if err != nil {
+		return "", fmt.Errorf("value of '--readme-template' is not a readable file: %s", err)
+	}
+	output, err := format.RenderReadme(config.Formatter, string(content), tfmodule, settings)
+	if err != nil {
+		return "", fmt.Errorf("value of '--readme-template' is not a valid template: %s", err)
+	}
+	return output, nil
+}
+
+// renderWith renders the already loaded 'tfmodule' with 'formatter'
+func renderWith(formatter string, settings *print.Settings, tfmodule *tfconf.Module) (string, error) {
+	printer, err := format.Factory(formatter, settings)
+	if err != nil {
+		return "", err
+	}
+	return printer.Print(tfmodule, settings)
+}
 
-		options.Path = args[0]
+// document is the rendered output of the module at 'path', along with the
+// output of each one of the targets of Config, ready to be printed out or
+// written. It's nil if the module is only checked in lint mode.
+type document struct {
+	path    string
+	output  string
+	targets []string
+}
 
-		tfmodule, err := module.LoadWithOptions(options)
+// prepare loads the module at 'path' only once and renders it with the
+// formatter of Config and each one of the targets, without printing or
+// writing anything, so modules can be prepared concurrently.
+func prepare(config *Config, path string) (*document, error) {
+	settings, tfmodule, err := load(config, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// lint mode, the module is only checked and nothing is rendered
+	if config.FailOnMissingDescription {
+		return nil, checkDescriptions(path, tfmodule)
+	}
+
+	output, err := renderOutput(config, path, settings, tfmodule)
+	if err != nil {
+		return nil, err
+	}
+	if output, err = postProcess(config, path, output); err != nil {
+		return nil, err
+	}
+
+	doc := &document{
+		path:    path,
+		output:  output,
+		targets: make([]string, 0, len(config.Targets)),
+	}
+	for _, t := range config.Targets {
+		// targets are always written into files, colors don't belong there
+		tsettings := *settings
+		tsettings.ShowColor = false
+
+		output, err := renderWith(t.Formatter, &tsettings, tfmodule)
 		if err != nil {
+			return nil, err
+		}
+		doc.targets = append(doc.targets, output)
+	}
+	return doc, nil
+}
+
+// emit prints the output of the document out or writes it into the output
+// file, and writes the output of each one of the targets into their file
+func (d *document) emit(config *Config) error {
+	if d == nil {
+		return nil
+	}
+	if config.Output.File == "" {
+		fmt.Println(d.output)
+	} else if err := write(config, d.path, config.Output.File, config.Output.Mode, d.output); err != nil {
+		return err
+	}
+	for i, t := range config.Targets {
+		if err := write(config, d.path, t.File, t.Mode, d.targets[i]); err != nil {
 			return err
+		}
+	}
+	return nil
+}
+
+// catalog renders all the modules found in 'root', the root module itself
+// excluded, into one document where each of them comes under a heading which
+// links to its directory. Headings of the modules are shifted one level down
+// to nest under it. The document is printed out or written into the output
+// file, relative to 'root'.
+func catalog(config *Config, root string) error {
+	paths, err := findSubmodules(root)
+	if err != nil {
+		return err
+	}
 
+	level := config.Settings.HeadingBaseLevel
+
+	submodules := make([]string, 0, len(paths))
+	for _, path := range paths {
+		if path != root {
+			submodules = append(submodules, path)
 		}
+	}
 
-		output, err := printer.Print(tfmodule, settings)
+	// modules are rendered concurrently, but joined in their order
+	docs := make([]string, len(submodules))
+	errs := parallelize(submodules, config.Parallelism, func(i int, path string) error {
+		settings, tfmodule, err := load(config, path)
 		if err != nil {
 			return err
 		}
+		if config.FailOnMissingDescription {
+			return checkDescriptions(path, tfmodule)
+		}
+		settings.HeadingBaseLevel = level + 1
+		output, err := renderWith(config.Formatter, settings, tfmodule)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		docs[i] = fmt.Sprintf("%s [%s](./%s)\n\n%s", strings.Repeat("#", level), rel, rel, strings.TrimSuffix(output, "\n"))
+		return nil
+	})
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	if config.FailOnMissingDescription {
+		return nil
+	}
+	if len(docs) == 0 {
+		return fmt.Errorf("no module found in %s", root)
+	}
 
+	output, err := postProcess(config, root, strings.Join(docs, "\n\n"))
+	if err != nil {
+		return err
+	}
+	if config.Output.File == "" {
 		fmt.Println(output)
+		return nil
+	}
+	return write(config, root, config.Output.File, config.Output.Mode, output)
+}
 
+// compare renders the changes of inputs and outputs of the module at 'path'
+// compared to its other version at '--diff', instead of its document. The
+// result is printed out or written into the output file, relative to 'path'.
+func compare(config *Config, path string) error {
+	_, before, err := load(config, config.Diff)
+	if err != nil {
+		return err
+	}
+	settings, after, err := load(config, path)
+	if err != nil {
+		return err
+	}
+
+	output, err := postProcess(config, path, format.RenderDiff(before, after, settings))
+	if err != nil {
+		return err
+	}
+	if config.Output.File == "" {
+		fmt.Println(output)
 		return nil
 	}
+	return write(config, path, config.Output.File, config.Output.Mode, output)
+}
+
+// streamline is one line of 'jsonl' output, the document of a module
+// along with its path relative to the root module
+type streamline struct {
+	Path   string          `json:"path"`
+	Module json.RawMessage `json:"module"`
+}
+
+// stream renders the modules of 'paths' one by one and writes each one into
+// 'w' on its own line as soon as it's rendered, so neither the modules nor
+// their documents are held in memory and consumers can process them as they
+// come.
+func stream(w io.Writer, config *Config, root string, paths []string) error {
+	for _, path := range paths {
+		settings, tfmodule, err := load(config, path)
+		if err != nil {
+			return err
+		}
+		if config.FailOnMissingDescription {
+			if err := checkDescriptions(path, tfmodule); err != nil {
+				return err
+			}
+			continue
+		}
+		output, err := renderWith(config.Formatter, settings, tfmodule)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(settings.EscapeCharacters())
+		if err := encoder.Encode(&streamline{Path: filepath.ToSlash(rel), Module: json.RawMessage(output)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// postProcess pipes the output through the 'PostProcess' command of Config,
+// if any, run with a shell in module 'path', and returns what it prints out.
+// It's applied before the output is checked too, so '--check' compares the
+// same content as the one which would be written.
+func postProcess(config *Config, path string, output string) (string, error) {
+	if config.PostProcess == "" {
+		return output, nil
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("sh", "-c", config.PostProcess)
+	cmd.Dir = path
+	cmd.Stdin = strings.NewReader(output)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("post-process command failed: %s: %s", err, msg)
+		}
+		return "", fmt.Errorf("post-process command failed: %s", err)
+	}
+	processed := stdout.String()
+	if !strings.HasSuffix(output, "\n") {
+		processed = strings.TrimRight(processed, "\n")
+	}
+	return processed, nil
+}
+
+// write the output into 'file', relative to module 'path', with 'mode'
+// or only check if 'file' is up to date with it. The status of the file
+// goes to stderr, so it never gets mixed with the output, and is omitted
+// in quiet mode.
+func write(config *Config, path string, file string, mode string, output string) error {
+	writer := &fileWriter{
+		file:  file,
+		dir:   path,
+		mode:  mode,
+		check: config.Output.Check,
+	}
+	if _, err := io.WriteString(writer, output); err != nil {
+		return err
+	}
+	if config.Quiet {
+		return nil
+	}
+	if config.Output.Check {
+		fmt.Fprintf(os.Stderr, "%s is up to date\n", writer.path())
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "%s updated successfully\n", writer.path())
+
+	return nil
+}
+
+// findSubmodules returns path of all the directories, nested in 'root',
+// which contain Terraform configuration files
+func findSubmodules(root string) ([]string, error) {
+	info, err := os.Stat(root)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("recursive path %s not found", root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("recursive path %s is not a directory", root)
+	}
+
+	submodules := make([]string, 0)
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != root && strings.HasPrefix(info.Name(), ".") {
+			return filepath.SkipDir // e.g. '.terraform'
+		}
+		files, err := ioutil.ReadDir(path)
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			if !f.IsDir() && filepath.Ext(f.Name()) == ".tf" {
+				submodules = append(submodules, path)
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return submodules, nil
+}
+
+// parallelize calls 'fn' with each one of 'paths' and its index, running at
+// most 'parallelism' of them concurrently, and returns the error of each one
+// of them in the order of 'paths'. The results are meant to be stored by 'fn'
+// at the index of their path, so they can be processed in a deterministic
+// order regardless of the order they were done in.
+func parallelize(paths []string, parallelism int, fn func(i int, path string) error) []error {
+	errs := make([]error, len(paths))
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < parallelism && w < len(paths); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = fn(i, paths[i])
+			}
+		}()
+	}
+	for i := range paths {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return errs
+}
+
+// isTerminal indicates if 'file' is attached to a terminal (i.e. TTY)
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// readConfig reads the config file, if any, and merges its values into
+// the provided Config. The file path is relative to the module 'path'.
+func readConfig(config *Config, path string) error {
+	if config.File == "" {
+		return fmt.Errorf("value of '--config' can't be empty")
+	}
+	file := config.File
+	if !filepath.IsAbs(file) {
+		file = filepath.Join(path, file)
+	}
+	cfgreader := &cfgreader{
+		file:       file,
+		config:     config,
+		fromSource: config.sourceDir != "" && isWithin(config.sourceDir, file),
+	}
+	if found, err := cfgreader.exist(); !found {
+		if config.flags.changed("config") {
+			return err // user explicitly asked for a file which doesn't exist
+		}
+		return nil // absorb the error, having a config file is optional
+	}
+	return cfgreader.parse()
 }
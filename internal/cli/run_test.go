@@ -0,0 +1,757 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalog(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "terraform-docs-catalog")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	modules := map[string]string{
+		"main.tf":            "variable \"root\" {\n  description = \"Root input.\"\n}\n",
+		"network/main.tf":    "variable \"cidr\" {\n  description = \"CIDR block.\"\n}\n",
+		"storage/s3/main.tf": "output \"bucket\" {\n  description = \"Name of bucket.\"\n  value       = \"foo\"\n}\n",
+	}
+	for file, content := range modules {
+		path := filepath.Join(root, file)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	config := DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Catalog = true
+	config.Quiet = true
+	config.Output.File = "CATALOG.md"
+	config.Output.Mode = "replace"
+	config.Sections.Show = []string{"inputs", "outputs"}
+	config.normalize()
+	assert.Nil(config.validate())
+
+	for _, parallelism := range []int{1, 4} {
+		config.Parallelism = parallelism
+		assert.Nil(catalog(config, root))
+
+		content, err := ioutil.ReadFile(filepath.Join(root, "CATALOG.md"))
+		assert.Nil(err)
+
+		expected := "## [network](./network)\n\n" +
+			"### Inputs\n\n" +
+			"| Name | Description | Type | Default | Required |\n" +
+			"|------|-------------|------|---------|:--------:|\n" +
+			"| cidr | CIDR block. | `any` | n/a | yes |\n\n" +
+			"### Outputs\n\n" +
+			"No output.\n\n" +
+			"## [storage/s3](./storage/s3)\n\n" +
+			"### Inputs\n\n" +
+			"No input.\n\n" +
+			"### Outputs\n\n" +
+			"| Name | Description |\n" +
+			"|------|-------------|\n" +
+			"| bucket | Name of bucket. |\n"
+		assert.Equal(expected, string(content))
+	}
+}
+
+func TestParallelize(t *testing.T) {
+	tests := []struct {
+		name        string
+		parallelism int
+	}{
+		{
+			name:        "serial",
+			parallelism: 1,
+		},
+		{
+			name:        "parallel",
+			parallelism: 3,
+		},
+		{
+			name:        "more workers than paths",
+			parallelism: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			paths := []string{"a", "b", "c", "d", "e"}
+			results := make([]string, len(paths))
+			errs := parallelize(paths, tt.parallelism, func(i int, path string) error {
+				if path == "d" {
+					return fmt.Errorf("failed %s", path)
+				}
+				results[i] = strings.ToUpper(path)
+				return nil
+			})
+
+			assert.Equal([]string{"A", "B", "C", "", "E"}, results)
+			assert.Equal([]error{nil, nil, nil, fmt.Errorf("failed d"), nil}, errs)
+		})
+	}
+}
+
+func TestCatalogValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		recursive bool
+		targets   targetlist
+		heading   int
+		wantErr   bool
+	}{
+		{
+			name:      "markdown table",
+			formatter: "markdown table",
+		},
+		{
+			name:      "heading base level 4",
+			formatter: "markdown table",
+			heading:   4,
+		},
+		{
+			name:      "heading base level 5",
+			formatter: "markdown table",
+			heading:   5,
+			wantErr:   true,
+		},
+		{
+			name:      "markdown document",
+			formatter: "markdown document",
+		},
+		{
+			name:      "not markdown",
+			formatter: "json",
+			wantErr:   true,
+		},
+		{
+			name:      "with recursive",
+			formatter: "markdown table",
+			recursive: true,
+			wantErr:   true,
+		},
+		{
+			name:      "with target",
+			formatter: "markdown table",
+			targets:   targetlist{{Formatter: "json", File: "docs.json", Mode: "replace"}},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Catalog = true
+			config.Recursive.Enabled = tt.recursive
+			config.Output.File = "README.md"
+			config.Targets = tt.targets
+			if tt.heading != 0 {
+				config.Settings.HeadingBaseLevel = tt.heading
+			}
+			config.normalize()
+
+			err := config.validate()
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "terraform-docs-compare")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	modules := map[string]string{
+		"v1/main.tf": "variable \"name\" {\n  type = string\n}\n\nvariable \"size\" {\n  default = 1\n}\n\noutput \"id\" {\n  value = \"foo\"\n}\n",
+		"v2/main.tf": "variable \"name\" {\n  type    = string\n  default = \"foo\"\n}\n\nvariable \"zone\" {\n  type = string\n}\n\noutput \"arn\" {\n  description = \"ARN of bucket.\"\n  value       = \"foo\"\n}\n",
+	}
+	for file, content := range modules {
+		path := filepath.Join(root, file)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	config := DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Diff = filepath.Join(root, "v1")
+	config.Quiet = true
+	config.Output.File = "CHANGES.md"
+	config.Output.Mode = "replace"
+	config.normalize()
+	assert.Nil(config.validate())
+
+	assert.Nil(compare(config, filepath.Join(root, "v2")))
+
+	content, err := ioutil.ReadFile(filepath.Join(root, "v2", "CHANGES.md"))
+	assert.Nil(err)
+
+	expected := "## Inputs\n\n" +
+		"### Added\n\n" +
+		"| Name | Type | Default |\n" +
+		"|------|------|---------|\n" +
+		"| zone | `string` | n/a |\n\n" +
+		"### Removed\n\n" +
+		"| Name | Type | Default |\n" +
+		"|------|------|---------|\n" +
+		"| size | `number` | `1` |\n\n" +
+		"### Changed\n\n" +
+		"| Name | Change | Before | After |\n" +
+		"|------|--------|--------|-------|\n" +
+		"| name | default | n/a | `\"foo\"` |\n" +
+		"| name | required | true | false |\n\n" +
+		"## Outputs\n\n" +
+		"### Added\n\n" +
+		"| Name | Description |\n" +
+		"|------|-------------|\n" +
+		"| arn | ARN of bucket. |\n\n" +
+		"### Removed\n\n" +
+		"| Name | Description |\n" +
+		"|------|-------------|\n" +
+		"| id | n/a |\n"
+	assert.Equal(expected, string(content))
+}
+
+func TestCompareValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		diff      string
+		catalog   bool
+		wantErr   bool
+	}{
+		{
+			name:      "markdown table",
+			formatter: "markdown table",
+			diff:      ".",
+		},
+		{
+			name:      "not markdown",
+			formatter: "json",
+			diff:      ".",
+			wantErr:   true,
+		},
+		{
+			name:      "not a directory",
+			formatter: "markdown table",
+			diff:      "run_test.go",
+			wantErr:   true,
+		},
+		{
+			name:      "with catalog",
+			formatter: "markdown table",
+			diff:      ".",
+			catalog:   true,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Diff = tt.diff
+			config.Catalog = tt.catalog
+			config.normalize()
+
+			err := config.validate()
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "terraform-docs-stream")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	modules := map[string]string{
+		"main.tf":            "variable \"root\" {\n  description = \"Root input.\"\n}\n",
+		"modules/network.tf": "variable \"cidr\" {\n  description = \"CIDR <block>.\"\n}\n",
+	}
+	for file, content := range modules {
+		path := filepath.Join(root, file)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	config := DefaultConfig()
+	config.Formatter = "jsonl"
+	config.Recursive.Enabled = true
+	config.Sections.Show = []string{"inputs"}
+	config.Settings.EscapeMode = "none"
+	config.normalize()
+	assert.Nil(config.validate())
+
+	buffer := new(bytes.Buffer)
+	assert.Nil(stream(buffer, config, root, []string{root, filepath.Join(root, "modules")}))
+
+	expected := `{"path":".","module":{"header":"","inputs":[{"name":"root","type":"any","description":"Root input.","default":null,"required":true}],"outputs":[],"providers":[],"requirements":[],"resources":[],"modules":[]}}` + "\n" +
+		`{"path":"modules","module":{"header":"","inputs":[{"name":"cidr","type":"any","description":"CIDR <block>.","default":null,"required":true}],"outputs":[],"providers":[],"requirements":[],"resources":[],"modules":[]}}` + "\n"
+	assert.Equal(expected, buffer.String())
+}
+
+func TestStreamValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		recursive  bool
+		outputFile string
+		targets    targetlist
+		wantErr    bool
+	}{
+		{
+			name: "root module",
+		},
+		{
+			name:      "recursive without output file",
+			recursive: true,
+		},
+		{
+			name:       "with output file",
+			outputFile: "README.md",
+			wantErr:    true,
+		},
+		{
+			name:    "with target",
+			targets: targetlist{{Formatter: "json", File: "docs.json", Mode: "replace"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = "jsonl"
+			config.Recursive.Enabled = tt.recursive
+			config.Output.File = tt.outputFile
+			config.Targets = tt.targets
+			config.normalize()
+
+			err := config.validate()
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
+
+func TestPostProcess(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "no command",
+			command:  "",
+			expected: "| foo |\n| bar |",
+		},
+		{
+			name:     "formatting command",
+			command:  "tr a-z A-Z",
+			expected: "| FOO |\n| BAR |",
+		},
+		{
+			name:     "command run in module",
+			command:  "cat main.tf -",
+			expected: "# main\n| foo |\n| bar |",
+		},
+		{
+			name:    "failing command",
+			command: "echo invalid input >&2; exit 1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			path, err := ioutil.TempDir("", "terraform-docs-post-process")
+			assert.Nil(err)
+			defer os.RemoveAll(path)
+			assert.Nil(ioutil.WriteFile(filepath.Join(path, "main.tf"), []byte("# main\n"), 0644))
+
+			config := DefaultConfig()
+			config.PostProcess = tt.command
+
+			actual, err := postProcess(config, path, "| foo |\n| bar |")
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), "invalid input")
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestPostProcessCheck(t *testing.T) {
+	assert := assert.New(t)
+
+	path, err := ioutil.TempDir("", "terraform-docs-post-process")
+	assert.Nil(err)
+	defer os.RemoveAll(path)
+	assert.Nil(ioutil.WriteFile(filepath.Join(path, "main.tf"), []byte("variable \"foo\" {\n  description = \"Foo input.\"\n}\n"), 0644))
+
+	config := DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Quiet = true
+	config.PostProcess = "tr a-z A-Z"
+	config.Output.File = "README.md"
+	config.Output.Mode = "replace"
+	config.Sections.Show = []string{"inputs"}
+	config.normalize()
+	assert.Nil(config.validate())
+
+	assert.Nil(RunEFunc(config)(nil, []string{path}))
+
+	content, err := ioutil.ReadFile(filepath.Join(path, "README.md"))
+	assert.Nil(err)
+	assert.Contains(string(content), "| FOO | FOO INPUT. |")
+
+	// the processed output is up to date, while the raw one wouldn't be
+	config.Output.Check = true
+	assert.Nil(RunEFunc(config)(nil, []string{path}))
+
+	config.PostProcess = "cat"
+	assert.NotNil(RunEFunc(config)(nil, []string{path}))
+}
+
+func TestPostProcessFromSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		fetched bool
+		wantErr bool
+	}{
+		{
+			name:    "local config file",
+			fetched: false,
+			wantErr: false,
+		},
+		{
+			name:    "config file of remote source",
+			fetched: true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir, err := ioutil.TempDir("", "terraform-docs-source")
+			assert.Nil(err)
+			defer os.RemoveAll(dir)
+
+			pwned := filepath.Join(dir, "pwned")
+			assert.Nil(ioutil.WriteFile(filepath.Join(dir, "main.tf"), []byte("variable \"foo\" {}\n"), 0644))
+			assert.Nil(ioutil.WriteFile(filepath.Join(dir, ".terraform-docs.yml"), []byte("post-process: \"touch "+pwned+"; cat\"\n"), 0644))
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Quiet = true
+			if tt.fetched {
+				config.Source = "git::https://example.com/modules.git"
+				config.sourceDir = dir
+				config.sourcePath = dir
+			}
+			assert.Nil(readConfig(config, dir))
+			config.normalize()
+
+			err = config.validate()
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), "'post-process' of the config file of '--source'")
+				_, err = os.Stat(pwned)
+				assert.True(os.IsNotExist(err))
+				return
+			}
+			assert.Nil(err)
+			_, err = postProcess(config, dir, "")
+			assert.Nil(err)
+			_, err = os.Stat(pwned)
+			assert.Nil(err)
+		})
+	}
+}
+
+func TestTargetlistSet(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected *target
+		wantErr  bool
+	}{
+		{
+			name:     "formatter and file",
+			value:    "json=docs.json",
+			expected: &target{Formatter: "json", File: "docs.json", Mode: "replace"},
+		},
+		{
+			name:     "formatter with subcommand and spaces",
+			value:    " markdown table = docs/README.md ",
+			expected: &target{Formatter: "markdown table", File: "docs/README.md", Mode: "replace"},
+		},
+		{
+			name:     "file with equal sign",
+			value:    "yaml=docs=v1.yaml",
+			expected: &target{Formatter: "yaml", File: "docs=v1.yaml", Mode: "replace"},
+		},
+		{
+			name:    "missing file",
+			value:   "json",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			targets := targetlist{}
+			err := targets.Set(tt.value)
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Empty(targets)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(targetlist{tt.expected}, targets)
+		})
+	}
+}
+
+func TestTargetsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   *target
+		expected string
+		errText  string
+	}{
+		{
+			name:     "mode defaults to replace",
+			target:   &target{Formatter: "json", File: "docs.json"},
+			expected: "replace",
+		},
+		{
+			name:     "inject mode",
+			target:   &target{Formatter: "markdown table", File: "README.md", Mode: "inject"},
+			expected: "inject",
+		},
+		{
+			name:    "missing formatter",
+			target:  &target{File: "docs.json"},
+			errText: "value of '--target' must be in the form of 'formatter=file'",
+		},
+		{
+			name:    "missing file",
+			target:  &target{Formatter: "json"},
+			errText: "value of '--target' must be in the form of 'formatter=file'",
+		},
+		{
+			name:    "unknown formatter",
+			target:  &target{Formatter: "html", File: "docs.html"},
+			errText: "value of '--target' is invalid",
+		},
+		{
+			name:    "unknown mode",
+			target:  &target{Formatter: "json", File: "docs.json", Mode: "append"},
+			errText: "mode of '--target' docs.json must be one of [inject, replace]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Targets = targetlist{tt.target}
+			config.normalize()
+
+			err := config.validate()
+			if tt.errText != "" {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), tt.errText)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.expected, tt.target.Mode)
+		})
+	}
+}
+
+func TestTargetsRecursive(t *testing.T) {
+	tests := []struct {
+		name      string
+		recursive bool
+		path      string
+		written   []string
+		skipped   []string
+		errText   string
+	}{
+		{
+			name:      "root module only",
+			recursive: false,
+			path:      "modules",
+			written:   []string{"."},
+			skipped:   []string{"modules/network", "modules/storage/s3", "modules/empty", "modules/.terraform/cache"},
+		},
+		{
+			name:      "root module and submodules",
+			recursive: true,
+			path:      "modules",
+			written:   []string{".", "modules/network", "modules/storage/s3"},
+			skipped:   []string{"modules/empty", "modules/.terraform/cache"},
+		},
+		{
+			name:      "root module and submodules of nested path",
+			recursive: true,
+			path:      "modules/storage",
+			written:   []string{".", "modules/storage/s3"},
+			skipped:   []string{"modules/network", "modules/empty", "modules/.terraform/cache"},
+		},
+		{
+			name:      "missing path of submodules",
+			recursive: true,
+			path:      "submodules",
+			errText:   "recursive path",
+		},
+		{
+			name:      "path of submodules not a directory",
+			recursive: true,
+			path:      "main.tf",
+			errText:   "is not a directory",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			root, err := ioutil.TempDir("", "terraform-docs-targets")
+			assert.Nil(err)
+			defer os.RemoveAll(root)
+
+			modules := map[string]string{
+				"main.tf":                          "variable \"root\" {}\n",
+				"modules/network/main.tf":          "variable \"cidr\" {}\n",
+				"modules/storage/s3/main.tf":       "output \"bucket\" {\n  value = \"foo\"\n}\n",
+				"modules/empty/notes.txt":          "no module here\n",
+				"modules/.terraform/cache/main.tf": "variable \"cached\" {}\n",
+			}
+			for file, content := range modules {
+				path := filepath.Join(root, file)
+				assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+				assert.Nil(ioutil.WriteFile(path, []byte(content), 0644))
+			}
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Quiet = true
+			config.Output.File = "README.md"
+			config.Recursive.Enabled = tt.recursive
+			config.Recursive.Path = tt.path
+			config.Targets = targetlist{
+				{Formatter: "json", File: "docs.json"},
+				{Formatter: "yaml", File: "docs/module.yaml"},
+			}
+			for _, dir := range tt.written {
+				assert.Nil(os.MkdirAll(filepath.Join(root, dir, "docs"), 0755))
+			}
+			config.normalize()
+			assert.Nil(config.validate())
+
+			err = RunEFunc(config)(nil, []string{root})
+			if tt.errText != "" {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), tt.errText)
+				return
+			}
+			assert.Nil(err)
+
+			for _, dir := range tt.written {
+				assert.FileExists(filepath.Join(root, dir, "README.md"))
+				assert.FileExists(filepath.Join(root, dir, "docs.json"))
+				assert.FileExists(filepath.Join(root, dir, "docs", "module.yaml"))
+			}
+			for _, dir := range tt.skipped {
+				assert.NoFileExists(filepath.Join(root, dir, "README.md"))
+				assert.NoFileExists(filepath.Join(root, dir, "docs.json"))
+			}
+
+			content, err := ioutil.ReadFile(filepath.Join(root, "docs.json"))
+			assert.Nil(err)
+			assert.Contains(string(content), "\"name\": \"root\"")
+		})
+	}
+}
+
+func TestDefaultValuesRecursive(t *testing.T) {
+	assert := assert.New(t)
+
+	root, err := ioutil.TempDir("", "terraform-docs-tfvars")
+	assert.Nil(err)
+	defer os.RemoveAll(root)
+
+	modules := map[string]string{
+		"main.tf":                     "variable \"name\" {}\n",
+		"prod.tfvars":                 "name = \"root-prod\"\n",
+		"modules/network/main.tf":     "variable \"name\" {}\n",
+		"modules/network/prod.tfvars": "name = \"network-prod\"\n",
+	}
+	for file, content := range modules {
+		path := filepath.Join(root, file)
+		assert.Nil(os.MkdirAll(filepath.Dir(path), 0755))
+		assert.Nil(ioutil.WriteFile(path, []byte(content), 0644))
+	}
+
+	config := DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Quiet = true
+	config.Output.File = "README.md"
+	config.Recursive.Enabled = true
+	config.DefaultValues = "prod.tfvars"
+	config.flags.set("default-values-file", true)
+	config.normalize()
+	assert.Nil(config.validate())
+
+	assert.Nil(RunEFunc(config)(nil, []string{root}))
+
+	content, err := ioutil.ReadFile(filepath.Join(root, "README.md"))
+	assert.Nil(err)
+	assert.Contains(string(content), "root-prod")
+	assert.NotContains(string(content), "network-prod")
+
+	content, err = ioutil.ReadFile(filepath.Join(root, "modules", "network", "README.md"))
+	assert.Nil(err)
+	assert.Contains(string(content), "network-prod")
+	assert.NotContains(string(content), "root-prod")
+}
@@ -0,0 +1,167 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// sourcePrefix is the prefix of remote module sources, the same as forced
+// Git sources of Terraform modules (e.g. 'git::https://example.com/x.git')
+const sourcePrefix = "git::"
+
+// list of all the schemes of remote module sources' URL
+var sourceSchemes = []string{"file", "http", "https", "ssh"}
+
+// scp-like address of a Git repository (e.g. 'git@example.com:org/repo.git')
+var scpLikeURL = regexp.MustCompile(`^[\w.-]+@[\w.-]+:[^/].*$`)
+
+// source is a remote Git repository which module is read from, in the form
+// of 'git::<url>[//<subdir>][?ref=<ref>]' similar to Terraform module sources
+// (e.g. 'git::https://example.com/modules.git//network?ref=v1.0.0')
+type source struct {
+	url    string
+	subdir string
+	ref    string
+}
+
+// parseSource parses 's' into source, returns an error if it's malformed
+func parseSource(s string) (*source, error) {
+	if !strings.HasPrefix(s, sourcePrefix) {
+		return nil, fmt.Errorf("'%s' prefix is missing", sourcePrefix)
+	}
+	raw := strings.TrimPrefix(s, sourcePrefix)
+
+	src := &source{}
+
+	if i := strings.Index(raw, "?"); i != -1 {
+		query, err := url.ParseQuery(raw[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		for key := range query {
+			if key != "ref" {
+				return nil, fmt.Errorf("parameter '%s' is not supported", key)
+			}
+		}
+		src.ref = query.Get("ref")
+		raw = raw[:i]
+	}
+
+	// subdir is separated with '//', which may only appear after scheme
+	start := 0
+	if i := strings.Index(raw, "://"); i != -1 {
+		start = i + len("://")
+	}
+	if i := strings.Index(raw[start:], "//"); i != -1 {
+		src.subdir = path.Clean(raw[start+i+2:])
+		raw = raw[:start+i]
+		if path.IsAbs(src.subdir) || src.subdir == ".." || strings.HasPrefix(src.subdir, "../") {
+			return nil, fmt.Errorf("subdir '%s' is outside of the repository", src.subdir)
+		}
+	}
+
+	if raw == "" {
+		return nil, fmt.Errorf("url of the repository is missing")
+	}
+	if strings.Contains(raw, "://") {
+		u, err := url.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+		if !contains(sourceSchemes, u.Scheme) {
+			return nil, fmt.Errorf("scheme '%s' is not supported, must be one of %v", u.Scheme, sourceSchemes)
+		}
+	} else if !scpLikeURL.MatchString(raw) {
+		return nil, fmt.Errorf("url '%s' is not supported", raw)
+	}
+	src.url = raw
+
+	return src, nil
+}
+
+// fetch clones the repository into a temporary directory, checks out its
+// 'ref', if any, and returns the path of the directory and the path of the
+// module in it. The directory must be removed by the caller.
+func (s *source) fetch() (string, string, error) {
+	dir, err := ioutil.TempDir("", "terraform-docs-")
+	if err != nil {
+		return "", "", err
+	}
+	if err := git("", "clone", "--quiet", "--", s.url, dir); err != nil {
+		os.RemoveAll(dir) //nolint:errcheck
+		return "", "", fmt.Errorf("failed to clone %s: %s", s.url, err)
+	}
+	if s.ref != "" {
+		if err := git(dir, "checkout", "--quiet", s.ref); err != nil {
+			os.RemoveAll(dir) //nolint:errcheck
+			return "", "", fmt.Errorf("failed to check out %s of %s: %s", s.ref, s.url, err)
+		}
+	}
+	modpath := filepath.Join(dir, filepath.FromSlash(s.subdir))
+	if info, err := os.Stat(modpath); err != nil || !info.IsDir() {
+		os.RemoveAll(dir) //nolint:errcheck
+		return "", "", fmt.Errorf("subdir %s not found in %s", s.subdir, s.url)
+	}
+	return dir, modpath, nil
+}
+
+// git runs git command with 'args' in 'dir' and returns its standard error
+// as the error, if it fails
+func git(dir string, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return errors.New(msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// fetchSource fetches the module of 'Source' into a temporary directory
+// and returns its path. The directory is removed with cleanupSource.
+func (c *Config) fetchSource() (string, error) {
+	src, err := parseSource(c.Source)
+	if err != nil {
+		return "", fmt.Errorf("value of '--source' is not a valid git source: %s", err)
+	}
+	dir, modpath, err := src.fetch()
+	if err != nil {
+		return "", err
+	}
+	c.sourceDir = dir
+	c.sourcePath = modpath
+	return modpath, nil
+}
+
+// isWithin indicates if 'path' is 'dir' itself or any file nested in it
+func isWithin(dir string, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// cleanupSource removes the temporary directory 'Source' is fetched into
+func (c *Config) cleanupSource() {
+	if c.sourceDir == "" {
+		return
+	}
+	os.RemoveAll(c.sourceDir) //nolint:errcheck
+	c.sourceDir = ""
+	c.sourcePath = ""
+}
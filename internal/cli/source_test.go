@@ -0,0 +1,355 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    *source
+		wantErr string
+	}{
+		{
+			name:   "file url",
+			source: "git::file:///tmp/modules",
+			want:   &source{url: "file:///tmp/modules"},
+		},
+		{
+			name:   "http url",
+			source: "git::http://example.com/modules.git",
+			want:   &source{url: "http://example.com/modules.git"},
+		},
+		{
+			name:   "https url with subdir and ref",
+			source: "git::https://example.com/modules.git//network?ref=v1.0.0",
+			want:   &source{url: "https://example.com/modules.git", subdir: "network", ref: "v1.0.0"},
+		},
+		{
+			name:   "ssh url",
+			source: "git::ssh://git@example.com/org/modules.git?ref=main",
+			want:   &source{url: "ssh://git@example.com/org/modules.git", ref: "main"},
+		},
+		{
+			name:   "scp-like url with nested subdir",
+			source: "git::git@example.com:org/modules.git//modules/network",
+			want:   &source{url: "git@example.com:org/modules.git", subdir: "modules/network"},
+		},
+		{
+			name:    "missing prefix",
+			source:  "https://example.com/modules.git",
+			wantErr: "'git::' prefix is missing",
+		},
+		{
+			name:    "unsupported scheme",
+			source:  "git::ftp://example.com/modules.git",
+			wantErr: "scheme 'ftp' is not supported",
+		},
+		{
+			name:    "unsupported url",
+			source:  "git::example.com/modules.git",
+			wantErr: "url 'example.com/modules.git' is not supported",
+		},
+		{
+			name:    "missing url",
+			source:  "git::?ref=main",
+			wantErr: "url of the repository is missing",
+		},
+		{
+			name:    "unsupported parameter",
+			source:  "git::https://example.com/modules.git?depth=1",
+			wantErr: "parameter 'depth' is not supported",
+		},
+		{
+			name:    "subdir outside of repository",
+			source:  "git::https://example.com/modules.git//../network",
+			wantErr: "subdir '../network' is outside of the repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := parseSource(tt.source)
+			if tt.wantErr != "" {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.want, actual)
+		})
+	}
+}
+
+func TestFetchSource(t *testing.T) {
+	assert := assert.New(t)
+
+	repo, err := ioutil.TempDir("", "terraform-docs-repo")
+	assert.Nil(err)
+	defer os.RemoveAll(repo)
+
+	assert.Nil(os.Mkdir(filepath.Join(repo, "network"), 0755))
+	assert.Nil(ioutil.WriteFile(filepath.Join(repo, "network", "main.tf"), []byte("variable \"foo\" {}\n"), 0644))
+	assert.Nil(git(repo, "init", "--quiet"))
+	assert.Nil(git(repo, "add", "."))
+	assert.Nil(git(repo, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"))
+
+	config := DefaultConfig()
+	config.Source = "git::file://" + filepath.ToSlash(repo) + "//network"
+
+	path, err := config.fetchSource()
+	assert.Nil(err)
+	assert.Equal(filepath.Join(config.sourceDir, "network"), path)
+	assert.Equal(path, config.sourcePath)
+	assert.FileExists(filepath.Join(path, "main.tf"))
+
+	dir := config.sourceDir
+	config.cleanupSource()
+	assert.Equal("", config.sourceDir)
+	assert.Equal("", config.sourcePath)
+	_, err = os.Stat(dir)
+	assert.True(os.IsNotExist(err))
+
+	// nothing to be removed anymore
+	config.cleanupSource()
+
+	config.Source = "git::file://" + filepath.ToSlash(repo) + "//missing"
+	_, err = config.fetchSource()
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "subdir missing not found")
+	assert.Equal("", config.sourceDir)
+}
+
+func TestSourceConfig(t *testing.T) {
+	tests := []struct {
+		name     string
+		fetched  bool
+		expected func(*assert.Assertions, *Config)
+	}{
+		{
+			name:    "config file of remote source",
+			fetched: true,
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal("", config.Output.File)
+				assert.False(config.Recursive.Enabled)
+				assert.Empty(config.Targets)
+				assert.Equal("", config.ReadmeTemplate)
+				assert.Equal(pathlist{"main.tf"}, config.HeaderFrom)
+				assert.Equal("", config.FooterFrom)
+				assert.Equal("", config.IncludeExamples)
+				assert.Equal("", config.DefaultValues)
+				assert.Equal("", config.Diff)
+			},
+		},
+		{
+			name:    "local config file",
+			fetched: false,
+			expected: func(assert *assert.Assertions, config *Config) {
+				assert.Equal("/tmp/README.md", config.Output.File)
+				assert.True(config.Recursive.Enabled)
+				assert.Len(config.Targets, 1)
+				assert.Equal("README.tpl", config.ReadmeTemplate)
+				assert.Equal(pathlist{"../../etc/passwd"}, config.HeaderFrom)
+				assert.Equal("footer.md", config.FooterFrom)
+				assert.Equal("examples/main.tf", config.IncludeExamples)
+				assert.Equal("prod.tfvars", config.DefaultValues)
+				assert.Equal("../v1", config.Diff)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir, err := ioutil.TempDir("", "terraform-docs-source")
+			assert.Nil(err)
+			defer os.RemoveAll(dir)
+
+			content := `header-from: ../../etc/passwd
+footer-from: footer.md
+include-examples: examples/main.tf
+default-values-file: prod.tfvars
+diff: ../v1
+readme-template: README.tpl
+recursive:
+  enabled: true
+targets:
+  - file: /tmp/README.md
+output:
+  file: /tmp/README.md
+`
+			assert.Nil(ioutil.WriteFile(filepath.Join(dir, ".terraform-docs.yml"), []byte(content), 0644))
+
+			config := DefaultConfig()
+			config.Source = "git::https://example.com/modules.git"
+			if tt.fetched {
+				config.sourceDir = dir
+				config.sourcePath = dir
+			}
+			assert.Nil(readConfig(config, dir))
+			config.normalize()
+
+			tt.expected(assert, config)
+		})
+	}
+}
+
+func TestSourceUntrustedFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr string
+	}{
+		{
+			name:   "include-examples outside of module",
+			config: "include-examples: %s\n",
+		},
+		{
+			name:   "default-values-file outside of module",
+			config: "default-values-file: %s\n",
+		},
+		{
+			name:   "diff outside of module",
+			config: "diff: %s\n",
+		},
+		{
+			name:    "output-template outside of module",
+			config:  "output-template: %s\n",
+			wantErr: "'output-template' of the config file of '--source' can't be read",
+		},
+		{
+			name:    "output-values-from outside of module",
+			config:  "output-values:\n  enabled: true\n  from: %s\n",
+			wantErr: "'output-values.from' of the config file of '--source' can't be read",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			secrets, err := ioutil.TempDir("", "terraform-docs-secrets")
+			assert.Nil(err)
+			defer os.RemoveAll(secrets)
+
+			secret := filepath.Join(secrets, "secret.tfvars")
+			assert.Nil(ioutil.WriteFile(secret, []byte("foo = \"s3cr3t\"\n"), 0644))
+
+			repo, err := ioutil.TempDir("", "terraform-docs-repo")
+			assert.Nil(err)
+			defer os.RemoveAll(repo)
+
+			// relative to the module, however deep it's cloned into
+			escaped := strings.Repeat("../", 32) + strings.TrimPrefix(filepath.ToSlash(secret), "/")
+			content := fmt.Sprintf(tt.config, escaped)
+
+			assert.Nil(ioutil.WriteFile(filepath.Join(repo, "main.tf"), []byte("variable \"foo\" {}\n"), 0644))
+			assert.Nil(ioutil.WriteFile(filepath.Join(repo, ".terraform-docs.yml"), []byte(content), 0644))
+			assert.Nil(git(repo, "init", "--quiet"))
+			assert.Nil(git(repo, "add", "."))
+			assert.Nil(git(repo, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"))
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Source = "git::file://" + filepath.ToSlash(repo)
+
+			path, err := config.fetchSource()
+			assert.Nil(err)
+			defer config.cleanupSource()
+
+			assert.Nil(readConfig(config, path))
+			config.normalize()
+
+			err = config.validate()
+			if tt.wantErr != "" {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+
+			output, err := render(config, path)
+			assert.Nil(err)
+			assert.NotContains(output, "s3cr3t")
+		})
+	}
+}
+
+func TestSourceLocalFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		config   func(*Config, string)
+		expected string
+	}{
+		{
+			name:    "default-values-file outside of module",
+			file:    "prod.tfvars",
+			content: "foo = \"from-local-tfvars\"\n",
+			config: func(config *Config, file string) {
+				config.DefaultValues = file
+				config.flags.set("default-values-file", true)
+			},
+			expected: "from-local-tfvars",
+		},
+		{
+			name:    "output-values-from outside of module",
+			file:    "outputs.json",
+			content: `{"bar": {"sensitive": false, "type": "string", "value": "from-local-outputs"}}`,
+			config: func(config *Config, file string) {
+				config.OutputValues.Enabled = true
+				config.OutputValues.From = pathlist{file}
+				config.flags.set("output-values", true)
+				config.flags.set("output-values-from", true)
+			},
+			expected: "from-local-outputs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			local, err := ioutil.TempDir("", "terraform-docs-local")
+			assert.Nil(err)
+			defer os.RemoveAll(local)
+
+			file := filepath.Join(local, tt.file)
+			assert.Nil(ioutil.WriteFile(file, []byte(tt.content), 0644))
+
+			repo, err := ioutil.TempDir("", "terraform-docs-repo")
+			assert.Nil(err)
+			defer os.RemoveAll(repo)
+
+			assert.Nil(ioutil.WriteFile(filepath.Join(repo, "main.tf"), []byte("variable \"foo\" {}\n\noutput \"bar\" {\n  value = var.foo\n}\n"), 0644))
+			assert.Nil(git(repo, "init", "--quiet"))
+			assert.Nil(git(repo, "add", "."))
+			assert.Nil(git(repo, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"))
+
+			config := DefaultConfig()
+			config.Formatter = "markdown table"
+			config.Source = "git::file://" + filepath.ToSlash(repo)
+			tt.config(config, file)
+
+			path, err := config.fetchSource()
+			assert.Nil(err)
+			defer config.cleanupSource()
+
+			assert.Nil(readConfig(config, path))
+			config.normalize()
+			assert.Nil(config.validate())
+
+			output, err := render(config, path)
+			assert.Nil(err)
+			assert.Contains(output, tt.expected)
+		})
+	}
+}
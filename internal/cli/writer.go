@@ -0,0 +1,127 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	outputBeginMarker = "<!-- BEGIN_TF_DOCS -->"
+	outputEndMarker   = "<!-- END_TF_DOCS -->"
+)
+
+// fileWriter writes the generated content into 'file', which is relative
+// to module 'dir'. Depending on 'mode' the content either replaces the whole
+// file or gets injected between begin and end markers of the file. If 'check'
+// is set, the file is only compared with what would have been written.
+type fileWriter struct {
+	file  string
+	dir   string
+	mode  string
+	check bool
+}
+
+func (fw *fileWriter) path() string {
+	if filepath.IsAbs(fw.file) {
+		return fw.file
+	}
+	return filepath.Join(fw.dir, fw.file)
+}
+
+// Write the content 'p' into the file
+func (fw *fileWriter) Write(p []byte) (int, error) {
+	filename := fw.path()
+
+	existing, err := ioutil.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+
+	content := string(p)
+	if fw.mode == "inject" {
+		content, err = inject(string(existing), content)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+
+	buffer := bytes.NewBufferString(strings.TrimSuffix(content, "\n"))
+	buffer.WriteString("\n")
+
+	if fw.check {
+		if summary := diff(string(existing), buffer.String()); summary != "" {
+			return 0, fmt.Errorf("%s is out of date\n%s", filename, summary)
+		}
+		return len(p), nil
+	}
+
+	if err := ioutil.WriteFile(filename, buffer.Bytes(), 0644); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// inject 'content' between begin and end markers of 'existing'. The
+// markers, surrounding 'content', get appended if they don't exist.
+func inject(existing string, content string) (string, error) {
+	block := outputBeginMarker + "\n" + strings.TrimSuffix(content, "\n") + "\n" + outputEndMarker
+
+	begin := strings.Index(existing, outputBeginMarker)
+	end := strings.Index(existing, outputEndMarker)
+
+	switch {
+	case begin == -1 && end == -1:
+		existing = strings.TrimRight(existing, "\n")
+		if existing == "" {
+			return block, nil
+		}
+		return existing + "\n\n" + block, nil
+	case begin == -1:
+		return "", fmt.Errorf("begin marker '%s' is missing", outputBeginMarker)
+	case end == -1:
+		return "", fmt.Errorf("end marker '%s' is missing", outputEndMarker)
+	case end < begin:
+		return "", fmt.Errorf("end marker '%s' is placed before begin marker '%s'", outputEndMarker, outputBeginMarker)
+	}
+	return existing[:begin] + block + existing[end+len(outputEndMarker):], nil
+}
+
+// diff returns a summary of the lines which differ between 'existing' and
+// 'expected' content, or empty string if they are the same. Line endings
+// and trailing newlines are ignored to prevent false positives.
+func diff(existing string, expected string) string {
+	normalize := func(s string) []string {
+		s = strings.Replace(s, "\r\n", "\n", -1)
+		return strings.Split(strings.TrimRight(s, "\n"), "\n")
+	}
+	current := normalize(existing)
+	generated := normalize(expected)
+
+	var first, count int
+	for i := 0; i < len(current) || i < len(generated); i++ {
+		if i < len(current) && i < len(generated) && current[i] == generated[i] {
+			continue
+		}
+		if count == 0 {
+			first = i
+		}
+		count++
+	}
+	if count == 0 {
+		return ""
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString(fmt.Sprintf("%d line(s) differ, first at line %d:\n", count, first+1))
+	if first < len(current) {
+		buffer.WriteString(fmt.Sprintf("- %s\n", current[first]))
+	}
+	if first < len(generated) {
+		buffer.WriteString(fmt.Sprintf("+ %s\n", generated[first]))
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInject(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		expected string
+		errText  string
+	}{
+		{
+			name:     "empty file",
+			existing: "",
+			expected: "<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->",
+		},
+		{
+			name:     "no markers",
+			existing: "# Title\n\nSome text.\n\n",
+			expected: "# Title\n\nSome text.\n\n<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->",
+		},
+		{
+			name:     "both markers",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\ncontent\n<!-- END_TF_DOCS -->\n\nFooter.\n",
+			expected: "# Title\n\n<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->\n\nFooter.\n",
+		},
+		{
+			name:     "both markers without content",
+			existing: "<!-- BEGIN_TF_DOCS --><!-- END_TF_DOCS -->",
+			expected: "<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->",
+		},
+		{
+			name:     "missing begin marker",
+			existing: "# Title\n\nold\n<!-- END_TF_DOCS -->\n",
+			errText:  "begin marker '<!-- BEGIN_TF_DOCS -->' is missing",
+		},
+		{
+			name:     "missing end marker",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n",
+			errText:  "end marker '<!-- END_TF_DOCS -->' is missing",
+		},
+		{
+			name:     "end marker before begin marker",
+			existing: "<!-- END_TF_DOCS -->\nold\n<!-- BEGIN_TF_DOCS -->\n",
+			errText:  "end marker '<!-- END_TF_DOCS -->' is placed before begin marker '<!-- BEGIN_TF_DOCS -->'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := inject(tt.existing, "content\n")
+			if tt.errText != "" {
+				assert.NotNil(err)
+				assert.Equal(tt.errText, err.Error())
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		expected string
+		summary  string
+	}{
+		{
+			name:     "same content",
+			existing: "foo\nbar\n",
+			expected: "foo\nbar\n",
+			summary:  "",
+		},
+		{
+			name:     "different line endings and trailing newlines",
+			existing: "foo\r\nbar\r\n\r\n",
+			expected: "foo\nbar",
+			summary:  "",
+		},
+		{
+			name:     "changed lines",
+			existing: "foo\nbar\nbaz\n",
+			expected: "foo\nBAR\nBAZ\n",
+			summary:  "2 line(s) differ, first at line 2:\n- bar\n+ BAR",
+		},
+		{
+			name:     "added lines",
+			existing: "foo\n",
+			expected: "foo\nbar\n",
+			summary:  "1 line(s) differ, first at line 2:\n+ bar",
+		},
+		{
+			name:     "removed lines",
+			existing: "foo\nbar\n",
+			expected: "foo\n",
+			summary:  "1 line(s) differ, first at line 2:\n- bar",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.summary, diff(tt.existing, tt.expected))
+		})
+	}
+}
+
+func TestFileWriter(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		mode     string
+		check    bool
+		expected string
+		errText  string
+	}{
+		{
+			name:     "replace missing file",
+			mode:     "replace",
+			expected: "content\n",
+		},
+		{
+			name:     "replace existing file",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n<!-- END_TF_DOCS -->\n",
+			mode:     "replace",
+			expected: "content\n",
+		},
+		{
+			name:     "inject into missing file",
+			mode:     "inject",
+			expected: "<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->\n",
+		},
+		{
+			name:     "inject into file without markers",
+			existing: "# Title\n",
+			mode:     "inject",
+			expected: "# Title\n\n<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->\n",
+		},
+		{
+			name:     "inject between markers",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n<!-- END_TF_DOCS -->\n",
+			mode:     "inject",
+			expected: "# Title\n\n<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->\n",
+		},
+		{
+			name:     "inject into file with missing end marker",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n",
+			mode:     "inject",
+			expected: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n",
+			errText:  ": end marker '<!-- END_TF_DOCS -->' is missing",
+		},
+		{
+			name:     "check up to date file",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->\n",
+			mode:     "inject",
+			check:    true,
+			expected: "# Title\n\n<!-- BEGIN_TF_DOCS -->\ncontent\n<!-- END_TF_DOCS -->\n",
+		},
+		{
+			name:     "check out of date file",
+			existing: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n<!-- END_TF_DOCS -->\n",
+			mode:     "inject",
+			check:    true,
+			expected: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\n<!-- END_TF_DOCS -->\n",
+			errText:  " is out of date\n1 line(s) differ, first at line 4:\n- old\n+ content",
+		},
+		{
+			name:    "check missing file",
+			mode:    "replace",
+			check:   true,
+			errText: " is out of date\n1 line(s) differ, first at line 1:\n- \n+ content",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir, err := ioutil.TempDir("", "terraform-docs-writer")
+			assert.Nil(err)
+			defer os.RemoveAll(dir)
+
+			file := filepath.Join(dir, "README.md")
+			if tt.existing != "" {
+				assert.Nil(ioutil.WriteFile(file, []byte(tt.existing), 0644))
+			}
+
+			writer := &fileWriter{
+				file:  "README.md",
+				dir:   dir,
+				mode:  tt.mode,
+				check: tt.check,
+			}
+			_, err = io.WriteString(writer, "content\n")
+			if tt.errText != "" {
+				assert.NotNil(err)
+				assert.Equal(file+tt.errText, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+
+			content, err := ioutil.ReadFile(file)
+			if tt.expected == "" {
+				assert.True(os.IsNotExist(err))
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(content))
+		})
+	}
+}
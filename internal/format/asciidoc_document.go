@@ -1,6 +1,7 @@
 package format
 
 import (
+	"strings"
 	"text/template"
 
 	"github.com/segmentio/terraform-docs/pkg/print"
@@ -18,11 +19,43 @@ const (
 	{{ end -}}
 	`
 
+	asciidocDocumentUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{- with .Module.Usage }}
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "usage" "Usage" }}{{ end }}
+
+			{{ usage . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	asciidocDocumentRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
-		{{ indent 0 "=" }} Requirements
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "requirements" "Requirements" }}{{ end }}
 		{{ if not .Module.Requirements }}
 			No requirements.
+		{{ else if .Settings.SplitRequirements }}
+			{{ indent 1 "=" }} Terraform
+			{{ if not .Module.TerraformRequirements }}
+				No Terraform requirement.
+			{{ else }}
+				The following Terraform version is needed by this module:
+				{{- range .Module.TerraformRequirements }}
+					{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+					- {{ name .Name }}{{ $version }}
+				{{- end }}
+			{{ end }}
+			{{ indent 1 "=" }} Providers
+			{{ if not .Module.ProviderRequirements }}
+				No provider requirement.
+			{{ else }}
+				The following provider versions are needed by this module:
+				{{- range .Module.ProviderRequirements }}
+					{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+					- {{ name .Name }}{{ $version }}
+				{{- end }}
+			{{ end }}
 		{{ else }}
 			The following requirements are needed by this module:
 			{{- range .Module.Requirements }}
@@ -35,14 +68,59 @@ const (
 
 	asciidocDocumentProvidersTpl = `
 	{{- if .Settings.ShowProviders -}}
-		{{ indent 0 "=" }} Providers
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "providers" "Providers" }}{{ end }}
 		{{ if not .Module.Providers }}
 			No provider.
 		{{ else }}
 			The following providers are used by this module:
 			{{- range .Module.Providers }}
 				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				- {{ name .FullName }}{{ $version }}
+				{{ $locked := ternary (and $.Settings.ShowLockedVersions (tostring .Locked)) (printf ", locked at %s" .Locked) "" }}
+				{{ $source := ternary (and $.Settings.ShowProviderSources (tostring .Source)) (printf " (%s)" (name (tostring .Source))) "" }}
+				- {{ name .FullName }}{{ $source }}{{ $version }}{{ $locked }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "modules" "Modules" }}{{ end }}
+		{{ if not .Module.ModuleCalls }}
+			No module.
+		{{ else }}
+			The following modules are called by this module:
+			{{- range .Module.ModuleCalls }}
+				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+				- {{ name .Name }} ({{ name .Source }}){{ $version }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "resources" "Resources" }}{{ end }}
+		{{ if not .Module.ManagedResources }}
+			No resource.
+		{{ else }}
+			The following resources are used by this module:
+			{{- range .Module.ManagedResources }}
+				- {{ name .FullType }}.{{ name .Name }} ({{ name .Provider }})
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentDataSourcesTpl = `
+	{{- if .Settings.ShowDataSources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "data-sources" "Data Sources" }}{{ end }}
+		{{ if not .Module.DataResources }}
+			No data source.
+		{{ else }}
+			The following data sources are read by this module:
+			{{- range .Module.DataResources }}
+				- {{ name .FullType }}.{{ name .Name }} ({{ name .Provider }})
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -70,7 +148,7 @@ const (
 				{{- end }}
 			{{ end }}
 		{{ else -}}
-			{{ indent 0 "=" }} Inputs
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "inputs" "Inputs" }}{{ end }}
 			{{ if not .Module.Inputs }}
 				No input.
 			{{ else }}
@@ -91,14 +169,22 @@ const (
 
 	Type: {{ tostring .Type | type }}
 
+	{{ if and .Sensitive showSensitivity }}
+		Sensitive: yes
+	{{ end }}
+
 	{{ if or .HasDefault (not isRequired) }}
-		Default: {{ default "n/a" .GetValue | value }}
+		Default: {{ or (noDefault .) (default placeholder .GetValue | defaultValue) }}
+	{{- end }}
+
+	{{ with .GetActualValue }}
+		Value: {{ value . }}
 	{{- end }}
 	`
 
 	asciidocDocumentOutputsTpl = `
 	{{- if .Settings.ShowOutputs -}}
-		{{ indent 0 "=" }} Outputs
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "outputs" "Outputs" }}{{ end }}
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
@@ -122,12 +208,66 @@ const (
 	{{ end -}}
 	`
 
+	asciidocDocumentChecksTpl = `
+	{{- if .Settings.ShowChecks -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "checks" "Checks" }}{{ end }}
+		{{ if not .Module.Checks }}
+			No check.
+		{{ else }}
+			The following checks validate the infrastructure:
+			{{- range .Module.Checks }}
+				{{ printf "\n" }}
+				{{ indent 1 "=" }} {{ name .Name }}
+				{{ printf "\n" }}
+				{{- range .Assertions }}
+					- {{ condition .Condition }}: {{ sanitizeDoc .ErrorMessage }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentMovedTpl = `
+	{{- if .Settings.ShowMoved -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "moved" "Moved" }}{{ end }}
+		{{ if not .Module.Moved }}
+			No moved block.
+		{{ else }}
+			The following addresses have been moved:
+			{{- range .Module.Moved }}
+				- {{ name .From }} to {{ name .To }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentImportsTpl = `
+	{{- if .Settings.ShowImports -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "imports" "Imports" }}{{ end }}
+		{{ if not .Module.Imports }}
+			No import block.
+		{{ else }}
+			The following resources are imported:
+			{{- range .Module.Imports }}
+				- {{ name .To }} with id {{ condition .ID }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	asciidocDocumentTpl = `
-	{{- template "header" . -}}
-	{{- template "requirements" . -}}
-	{{- template "providers" . -}}
-	{{- template "inputs" . -}}
-	{{- template "outputs" . -}}
+	{{- range sections -}}
+		{{- include . $ -}}
+	{{- end -}}
 	`
 )
 
@@ -144,12 +284,24 @@ func NewAsciidocDocument(settings *print.Settings) *AsciidocDocument {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: asciidocDocumentHeaderTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: asciidocDocumentUsageTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: asciidocDocumentRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: asciidocDocumentProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: asciidocDocumentModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: asciidocDocumentResourcesTpl,
+	}, &tmpl.Item{
+		Name: "data-sources",
+		Text: asciidocDocumentDataSourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: asciidocDocumentInputsTpl,
@@ -159,10 +311,26 @@ func NewAsciidocDocument(settings *print.Settings) *AsciidocDocument {
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: asciidocDocumentOutputsTpl,
+	}, &tmpl.Item{
+		Name: "checks",
+		Text: asciidocDocumentChecksTpl,
+	}, &tmpl.Item{
+		Name: "moved",
+		Text: asciidocDocumentMovedTpl,
+	}, &tmpl.Item{
+		Name: "imports",
+		Text: asciidocDocumentImportsTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: asciidocDocumentFooterTpl,
 	})
-	settings.EscapeCharacters = false
+	settings.EscapeMode = "none"
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"usage": printAsciidocUsageBlock,
+		"condition": func(c string) string {
+			return printInlineCode(c)
+		},
 		"type": func(t string) string {
 			result, extraline := printFencedAsciidocCodeBlock(t, "hcl")
 			if !extraline {
@@ -171,7 +339,7 @@ func NewAsciidocDocument(settings *print.Settings) *AsciidocDocument {
 			return result
 		},
 		"value": func(v string) string {
-			if v == "n/a" {
+			if v == settings.Placeholder {
 				return v
 			}
 			result, extraline := printFencedAsciidocCodeBlock(v, "json")
@@ -180,6 +348,19 @@ func NewAsciidocDocument(settings *print.Settings) *AsciidocDocument {
 			}
 			return result
 		},
+		"defaultValue": func(v string) string {
+			if v == settings.Placeholder {
+				return v
+			}
+			result, extraline := printFencedAsciidocCodeBlock(defaultValue(v, settings), defaultLanguage(settings))
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"showSensitivity": func() bool {
+			return settings.ShowSensitivity
+		},
 		"isRequired": func() bool {
 			return settings.ShowRequired
 		},
@@ -195,5 +376,8 @@ func (d *AsciidocDocument) Print(module *tfconf.Module, settings *print.Settings
 	if err != nil {
 		return "", err
 	}
-	return sanitize(rendered), nil
+	if settings.HideHeadings {
+		rendered = strings.TrimLeft(rendered, "\n")
+	}
+	return sanitize(appendMeta(rendered, settings)), nil
 }
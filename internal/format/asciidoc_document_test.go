@@ -131,11 +131,14 @@ func TestAsciidocDocumentSortByType(t *testing.T) {
 func TestAsciidocDocumentNoHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-NoHeader")
@@ -155,11 +158,14 @@ func TestAsciidocDocumentNoHeader(t *testing.T) {
 func TestAsciidocDocumentNoInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-NoInputs")
@@ -179,11 +185,14 @@ func TestAsciidocDocumentNoInputs(t *testing.T) {
 func TestAsciidocDocumentNoOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-NoOutputs")
@@ -203,11 +212,14 @@ func TestAsciidocDocumentNoOutputs(t *testing.T) {
 func TestAsciidocDocumentNoProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-NoProviders")
@@ -227,11 +239,14 @@ func TestAsciidocDocumentNoProviders(t *testing.T) {
 func TestAsciidocDocumentNoRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-NoRequirements")
@@ -248,14 +263,98 @@ func TestAsciidocDocumentNoRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAsciidocDocumentNoResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-NoResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentNoDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-NoDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentNoModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-NoModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestAsciidocDocumentOnlyHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-OnlyHeader")
@@ -275,11 +374,14 @@ func TestAsciidocDocumentOnlyHeader(t *testing.T) {
 func TestAsciidocDocumentOnlyInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-OnlyInputs")
@@ -296,14 +398,45 @@ func TestAsciidocDocumentOnlyInputs(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAsciidocDocumentHideHeadings(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		HideHeadings:     true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-HideHeadings")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestAsciidocDocumentOnlyOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-OnlyOutputs")
@@ -323,11 +456,14 @@ func TestAsciidocDocumentOnlyOutputs(t *testing.T) {
 func TestAsciidocDocumentOnlyProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-OnlyProviders")
@@ -347,11 +483,14 @@ func TestAsciidocDocumentOnlyProviders(t *testing.T) {
 func TestAsciidocDocumentOnlyRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-OnlyRequirements")
@@ -368,10 +507,189 @@ func TestAsciidocDocumentOnlyRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAsciidocDocumentOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-OnlyResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentOnlyDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-OnlyDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentOnlyMoved(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-OnlyMoved")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowMoved: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentOnlyImports(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowImports:      true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-OnlyImports")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowImports: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentOnlyChecks(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowChecks:       true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-OnlyChecks")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowChecks: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentOnlyModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-OnlyModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestAsciidocDocumentIndentationBelowAllowed(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 0,
+		HeadingBaseLevel: 0,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-IndentationBelowAllowed")
@@ -391,7 +709,7 @@ func TestAsciidocDocumentIndentationBelowAllowed(t *testing.T) {
 func TestAsciidocDocumentIndentationAboveAllowed(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 10,
+		HeadingBaseLevel: 10,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-IndentationAboveAllowed")
@@ -411,7 +729,7 @@ func TestAsciidocDocumentIndentationAboveAllowed(t *testing.T) {
 func TestAsciidocDocumentIndentationOfFour(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 4,
+		HeadingBaseLevel: 4,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "document-IndentationOfFour")
@@ -439,8 +757,8 @@ func TestAsciidocDocumentOutputValues(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
 	})
 	assert.Nil(err)
 
@@ -490,7 +808,7 @@ func TestAsciidocDocumentHeaderFromFile(t *testing.T) {
 			assert.Nil(err)
 
 			options, err := module.NewOptions().WithOverwrite(&module.Options{
-				HeaderFromFile: tt.file,
+				HeaderFromFiles: []string{tt.file},
 			})
 			assert.Nil(err)
 
@@ -517,8 +835,8 @@ func TestAsciidocDocumentOutputValuesNoSensitivity(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
 	})
 	assert.Nil(err)
 
@@ -542,7 +860,7 @@ func TestAsciidocDocumentEmpty(t *testing.T) {
 	}).Build()
 
 	options, err := module.NewOptions().WithOverwrite(&module.Options{
-		HeaderFromFile: "bad.tf",
+		HeaderFromFiles: []string{"bad.tf"},
 	})
 	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
 	assert.Nil(err)
@@ -556,3 +874,160 @@ func TestAsciidocDocumentEmpty(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal("", actual)
 }
+
+func TestAsciidocDocumentWithFooter(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowFooter: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-WithFooter")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowFooter:     true,
+		FooterFromFile: "footer.md",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentWithUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowUsage: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-WithUsage")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowUsage:     true,
+		UsageFromFile: "examples/basic/main.tf",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentLockedVersions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowLockedVersions: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-LockedVersions")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowLockedVersions: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentProviderSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowProviderSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-ProviderSources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowProviderSources: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentDefaultsAsHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		DefaultsAsHCL: true,
+		ShowInputs:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-DefaultsAsHCL")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentSplitRequirements(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SplitRequirements: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-SplitRequirements")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocDocumentSectionsOrder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionsOrder: []string{"outputs", "inputs"},
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "document-SectionsOrder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
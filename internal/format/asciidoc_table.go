@@ -1,6 +1,7 @@
 package format
 
 import (
+	"strings"
 	"text/template"
 
 	"github.com/segmentio/terraform-docs/pkg/print"
@@ -12,7 +13,18 @@ const (
 	asciidocTableHeaderTpl = `
 	{{- if .Settings.ShowHeader -}}
 		{{- with .Module.Header -}}
-			{{ sanitizeHeader . }}
+			{{ sanitizeAsciidocHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	asciidocTableUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{- with .Module.Usage }}
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "usage" "Usage" }}{{ end }}
+
+			{{ usage . }}
 			{{ printf "\n" }}
 		{{- end -}}
 	{{ end -}}
@@ -20,15 +32,40 @@ const (
 
 	asciidocTableRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
-		{{ indent 0 "=" }} Requirements
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "requirements" "Requirements" }}{{ end }}
 		{{ if not .Module.Requirements }}
 			No requirements.
+		{{ else if .Settings.SplitRequirements }}
+			{{ indent 1 "=" }} Terraform
+			{{ if not .Module.TerraformRequirements }}
+				No Terraform requirement.
+			{{ else }}
+				{{ if .Settings.ShowConstraintSource }}[cols="a,a,a",options="header,autowidth"]{{ else }}[cols="a,a",options="header,autowidth"]{{ end }}
+				|===
+				|Name |Version{{ if .Settings.ShowConstraintSource }} |Source{{ end }}
+				{{- range .Module.TerraformRequirements }}
+					|{{ .Name }} |{{ tostring .Version | default placeholder | sanitizeAsciidocTbl }}{{ if $.Settings.ShowConstraintSource }} |{{ .Position.String | default placeholder }}{{ end }}
+				{{- end }}
+				|===
+			{{ end }}
+			{{ indent 1 "=" }} Providers
+			{{ if not .Module.ProviderRequirements }}
+				No provider requirement.
+			{{ else }}
+				{{ if .Settings.ShowConstraintSource }}[cols="a,a,a",options="header,autowidth"]{{ else }}[cols="a,a",options="header,autowidth"]{{ end }}
+				|===
+				|Name |Version{{ if .Settings.ShowConstraintSource }} |Source{{ end }}
+				{{- range .Module.ProviderRequirements }}
+					|{{ .Name }} |{{ tostring .Version | default placeholder | sanitizeAsciidocTbl }}{{ if $.Settings.ShowConstraintSource }} |{{ .Position.String | default placeholder }}{{ end }}
+				{{- end }}
+				|===
+			{{ end }}
 		{{ else }}
-			[cols="a,a",options="header,autowidth"]
+			{{ if .Settings.ShowConstraintSource }}[cols="a,a,a",options="header,autowidth"]{{ else }}[cols="a,a",options="header,autowidth"]{{ end }}
 			|===
-			|Name |Version
+			|Name |Version{{ if .Settings.ShowConstraintSource }} |Source{{ end }}
 			{{- range .Module.Requirements }}
-				|{{ .Name }} |{{ tostring .Version | default "n/a" }}
+				|{{ .Name }} |{{ tostring .Version | default placeholder | sanitizeAsciidocTbl }}{{ if $.Settings.ShowConstraintSource }} |{{ .Position.String | default placeholder }}{{ end }}
 			{{- end }}
 			|===
 		{{ end }}
@@ -37,15 +74,66 @@ const (
 
 	asciidocTableProvidersTpl = `
 	{{- if .Settings.ShowProviders -}}
-		{{ indent 0 "=" }} Providers
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "providers" "Providers" }}{{ end }}
 		{{ if not .Module.Providers }}
 			No provider.
 		{{ else }}
-			[cols="a,a",options="header,autowidth"]
+			[cols="a{{ if .Settings.ShowProviderSources }},a{{ end }},a{{ if .Settings.ShowLockedVersions }},a{{ end }}",options="header,autowidth"]
 			|===
-			|Name |Version
+			|Name{{ if .Settings.ShowProviderSources }} |Source{{ end }} |Version{{ if .Settings.ShowLockedVersions }} |Locked{{ end }}
 			{{- range .Module.Providers }}
-				|{{ .FullName }} |{{ tostring .Version | default "n/a" }}
+				|{{ .FullName }}{{ if $.Settings.ShowProviderSources }} |{{ tostring .Source | default placeholder }}{{ end }} |{{ tostring .Version | default placeholder | sanitizeAsciidocTbl }}{{ if $.Settings.ShowLockedVersions }} |{{ tostring .Locked | default placeholder }}{{ end }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "modules" "Modules" }}{{ end }}
+		{{ if not .Module.ModuleCalls }}
+			No module.
+		{{ else }}
+			[cols="a,a,a",options="header,autowidth"]
+			|===
+			|Name |Source |Version
+			{{- range .Module.ModuleCalls }}
+				|{{ .Name }} |{{ .Source }} |{{ tostring .Version | default placeholder | sanitizeAsciidocTbl }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "resources" "Resources" }}{{ end }}
+		{{ if not .Module.ManagedResources }}
+			No resource.
+		{{ else }}
+			[cols="a,a,a",options="header,autowidth"]
+			|===
+			|Type |Name |Provider
+			{{- range .Module.ManagedResources }}
+				|{{ .FullType }} |{{ .Name }} |{{ .Provider }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableDataSourcesTpl = `
+	{{- if .Settings.ShowDataSources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "data-sources" "Data Sources" }}{{ end }}
+		{{ if not .Module.DataResources }}
+			No data source.
+		{{ else }}
+			[cols="a,a,a",options="header,autowidth"]
+			|===
+			|Type |Name |Provider
+			{{- range .Module.DataResources }}
+				|{{ .FullType }} |{{ .Name }} |{{ .Provider }}
 			{{- end }}
 			|===
 		{{ end }}
@@ -54,19 +142,23 @@ const (
 
 	asciidocTableInputsTpl = `
 	{{- if .Settings.ShowInputs -}}
-		{{ indent 0 "=" }} Inputs
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "inputs" "Inputs" }}{{ end }}
 		{{ if not .Module.Inputs }}
 			No input.
 		{{ else }}
-			[cols="a,a,a,a{{ if .Settings.ShowRequired }},a{{ end }}",options="header,autowidth"]
+			[cols="a,a,a,a{{ if showInputValues }},a{{ end }}{{ if .Settings.ShowRequired }},a{{ end }}{{ if and .Settings.ShowSensitivity .Module.HasSensitiveInputs }},a{{ end }}",options="header,autowidth"]
 			|===
-			|Name |Description |Type |Default{{ if .Settings.ShowRequired }} |Required{{ end }}
+			|Name |Description |Type |Default{{ if showInputValues }} |Value{{ end }}{{ if .Settings.ShowRequired }} |Required{{ end }}{{ if and .Settings.ShowSensitivity .Module.HasSensitiveInputs }} |Sensitive{{ end }}
 			{{- range .Module.Inputs }}
 				|{{ .Name }}
 				|{{ tostring .Description | sanitizeAsciidocTbl }}
 				|{{ tostring .Type | type | sanitizeAsciidocTbl }}
-				|{{ value .GetValue | sanitizeAsciidocTbl }}
+				|{{ or (noDefault .) (value (defaultValue .GetValue) | sanitizeAsciidocTbl) }}
+				{{- if showInputValues }}
+					|{{ value .GetActualValue | sanitizeAsciidocTbl }}
+				{{- end }}
 				{{ if $.Settings.ShowRequired }}|{{ ternary .Required "yes" "no" }}{{ end }}
+				{{- if and $.Settings.ShowSensitivity $.Module.HasSensitiveInputs }}{{ if $.Settings.ShowRequired }} {{ end }}|{{ ternary .Sensitive "yes" "no" }}{{ end }}
 			{{ end }}
 			|===
 		{{ end }}
@@ -75,7 +167,7 @@ const (
 
 	asciidocTableOutputsTpl = `
 	{{- if .Settings.ShowOutputs -}}
-		{{ indent 0 "=" }} Outputs
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "outputs" "Outputs" }}{{ end }}
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
@@ -86,7 +178,7 @@ const (
 				|{{ .Name }} |{{ tostring .Description | sanitizeAsciidocTbl }}
 				{{- if $.Settings.OutputValues -}}
 					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
-					{{ printf " " }}|{{ value $sensitive }}
+					{{ printf " " }}|{{ value $sensitive | sanitizeAsciidocTbl }}
 					{{- if $.Settings.ShowSensitivity -}}
 						{{ printf " " }}|{{ ternary .Sensitive "yes" "no" }}
 					{{- end -}}
@@ -97,12 +189,70 @@ const (
 	{{ end -}}
 	`
 
+	asciidocTableChecksTpl = `
+	{{- if .Settings.ShowChecks -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "checks" "Checks" }}{{ end }}
+		{{ if not .Module.Checks }}
+			No check.
+		{{ else }}
+			[cols="a,a",options="header,autowidth"]
+			|===
+			|Name |Assertions
+			{{- range .Module.Checks }}
+				|{{ .Name }} |{{ range $i, $v := .Assertions }}{{ if $i }} +{{ printf "\n" }}{{ end }}{{ condition .Condition | sanitizeAsciidocTbl }}: {{ sanitizeAsciidocTbl .ErrorMessage }}{{ else }}{{ placeholder }}{{ end }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableMovedTpl = `
+	{{- if .Settings.ShowMoved -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "moved" "Moved" }}{{ end }}
+		{{ if not .Module.Moved }}
+			No moved block.
+		{{ else }}
+			[cols="a,a",options="header,autowidth"]
+			|===
+			|From |To
+			{{- range .Module.Moved }}
+				|{{ .From }} |{{ .To }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableImportsTpl = `
+	{{- if .Settings.ShowImports -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "=" }} {{ title "imports" "Imports" }}{{ end }}
+		{{ if not .Module.Imports }}
+			No import block.
+		{{ else }}
+			[cols="a,a",options="header,autowidth"]
+			|===
+			|To |ID
+			{{- range .Module.Imports }}
+				|{{ .To }} |{{ condition .ID | sanitizeAsciidocTbl }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeAsciidocHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	asciidocTableTpl = `
-	{{- template "header" . -}}
-	{{- template "requirements" . -}}
-	{{- template "providers" . -}}
-	{{- template "inputs" . -}}
-	{{- template "outputs" . -}}
+	{{- range sections -}}
+		{{- include . $ -}}
+	{{- end -}}
 	`
 )
 
@@ -119,33 +269,66 @@ func NewAsciidocTable(settings *print.Settings) *AsciidocTable {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: asciidocTableHeaderTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: asciidocTableUsageTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: asciidocTableRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: asciidocTableProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: asciidocTableModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: asciidocTableResourcesTpl,
+	}, &tmpl.Item{
+		Name: "data-sources",
+		Text: asciidocTableDataSourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: asciidocTableInputsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: asciidocTableOutputsTpl,
+	}, &tmpl.Item{
+		Name: "checks",
+		Text: asciidocTableChecksTpl,
+	}, &tmpl.Item{
+		Name: "moved",
+		Text: asciidocTableMovedTpl,
+	}, &tmpl.Item{
+		Name: "imports",
+		Text: asciidocTableImportsTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: asciidocTableFooterTpl,
 	})
-	settings.EscapeCharacters = false
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"usage": printAsciidocUsageBlock,
+		"condition": func(c string) string {
+			return printInlineCode(c)
+		},
 		"type": func(t string) string {
-			inputType, _ := printFencedCodeBlock(t, "")
+			inputType, _ := printFencedCodeBlock(truncate(t, settings.TypeMaxLength), "")
 			return inputType
 		},
 		"value": func(v string) string {
-			var result = "n/a"
+			var result = settings.Placeholder
 			if v != "" {
 				result, _ = printFencedCodeBlock(v, "")
 			}
 			return result
 		},
+		"defaultValue": func(v string) string {
+			return defaultValue(v, settings)
+		},
+		"showInputValues": func() bool {
+			return settings.OutputValues && settings.ShowInputValues
+		},
 	})
 	return &AsciidocTable{
 		template: tt,
@@ -158,5 +341,8 @@ func (t *AsciidocTable) Print(module *tfconf.Module, settings *print.Settings) (
 	if err != nil {
 		return "", err
 	}
-	return sanitize(rendered), nil
+	if settings.HideHeadings {
+		rendered = strings.TrimLeft(rendered, "\n")
+	}
+	return sanitize(appendMeta(rendered, settings)), nil
 }
@@ -131,11 +131,14 @@ func TestAsciidocTableSortByType(t *testing.T) {
 func TestAsciidocTableNoHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-NoHeader")
@@ -155,11 +158,14 @@ func TestAsciidocTableNoHeader(t *testing.T) {
 func TestAsciidocTableNoInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-NoInputs")
@@ -179,11 +185,14 @@ func TestAsciidocTableNoInputs(t *testing.T) {
 func TestAsciidocTableNoOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-NoOutputs")
@@ -203,11 +212,14 @@ func TestAsciidocTableNoOutputs(t *testing.T) {
 func TestAsciidocTableNoProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-NoProviders")
@@ -227,11 +239,14 @@ func TestAsciidocTableNoProviders(t *testing.T) {
 func TestAsciidocTableNoRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-NoRequirements")
@@ -248,14 +263,98 @@ func TestAsciidocTableNoRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAsciidocTableNoResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-NoResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableNoDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-NoDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableNoModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-NoModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestAsciidocTableOnlyHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-OnlyHeader")
@@ -275,11 +374,14 @@ func TestAsciidocTableOnlyHeader(t *testing.T) {
 func TestAsciidocTableOnlyInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-OnlyInputs")
@@ -296,14 +398,45 @@ func TestAsciidocTableOnlyInputs(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAsciidocTableHideHeadings(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		HideHeadings:     true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-HideHeadings")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestAsciidocTableOnlyOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-OnlyOutputs")
@@ -323,11 +456,14 @@ func TestAsciidocTableOnlyOutputs(t *testing.T) {
 func TestAsciidocTableOnlyProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-OnlyProviders")
@@ -347,11 +483,14 @@ func TestAsciidocTableOnlyProviders(t *testing.T) {
 func TestAsciidocTableOnlyRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-OnlyRequirements")
@@ -368,10 +507,209 @@ func TestAsciidocTableOnlyRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAsciidocTableOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-OnlyResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableOnlyDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-OnlyDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableOnlyMoved(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-OnlyMoved")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowMoved: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableOnlyImports(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowImports:      true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-OnlyImports")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowImports: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableOnlyChecks(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowChecks:       true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-OnlyChecks")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowChecks: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableOnlyModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-OnlyModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableEscapeCharacters(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		EscapeMode: "markdown",
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-EscapeCharacters")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestAsciidocTableIndentationBelowAllowed(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 0,
+		HeadingBaseLevel: 0,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-IndentationBelowAllowed")
@@ -391,7 +729,7 @@ func TestAsciidocTableIndentationBelowAllowed(t *testing.T) {
 func TestAsciidocTableIndentationAboveAllowed(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 10,
+		HeadingBaseLevel: 10,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-IndentationAboveAllowed")
@@ -411,7 +749,7 @@ func TestAsciidocTableIndentationAboveAllowed(t *testing.T) {
 func TestAsciidocTableIndentationOfFour(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 4,
+		HeadingBaseLevel: 4,
 	}).Build()
 
 	expected, err := testutil.GetExpected("asciidoc", "table-IndentationOfFour")
@@ -439,8 +777,8 @@ func TestAsciidocTableOutputValues(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
 	})
 	assert.Nil(err)
 
@@ -490,7 +828,7 @@ func TestAsciidocTableHeaderFromFile(t *testing.T) {
 			assert.Nil(err)
 
 			options, err := module.NewOptions().WithOverwrite(&module.Options{
-				HeaderFromFile: tt.file,
+				HeaderFromFiles: []string{tt.file},
 			})
 			assert.Nil(err)
 
@@ -517,8 +855,8 @@ func TestAsciidocTableOutputValuesNoSensitivity(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
 	})
 	assert.Nil(err)
 
@@ -542,7 +880,7 @@ func TestAsciidocTableEmpty(t *testing.T) {
 	}).Build()
 
 	options, err := module.NewOptions().WithOverwrite(&module.Options{
-		HeaderFromFile: "bad.tf",
+		HeaderFromFiles: []string{"bad.tf"},
 	})
 	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
 	assert.Nil(err)
@@ -556,3 +894,244 @@ func TestAsciidocTableEmpty(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal("", actual)
 }
+
+func TestAsciidocTableWithFooter(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowFooter: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-WithFooter")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowFooter:     true,
+		FooterFromFile: "footer.md",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableWithUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowUsage: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-WithUsage")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowUsage:     true,
+		UsageFromFile: "examples/basic/main.tf",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableSectionTitles(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionTitles: map[string]string{
+			"inputs":  "Variables",
+			"outputs": "Exports",
+		},
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-SectionTitles")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableLockedVersions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowLockedVersions: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-LockedVersions")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowLockedVersions: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableProviderSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowProviderSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-ProviderSources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowProviderSources: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableDefaultsAsHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		DefaultsAsHCL: true,
+		ShowInputs:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-DefaultsAsHCL")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTablePlaceholder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		Placeholder: "-",
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-Placeholder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableSplitRequirements(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SplitRequirements: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-SplitRequirements")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableConstraintSource(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowConstraintSource: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-ConstraintSource")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableSectionsOrder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionsOrder: []string{"outputs", "inputs"},
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-SectionsOrder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestAsciidocTableTypeMaxLength(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:    true,
+		TypeMaxLength: 20,
+	}).Build()
+
+	expected, err := testutil.GetExpected("asciidoc", "table-TypeMaxLength")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewAsciidocTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
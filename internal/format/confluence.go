@@ -0,0 +1,392 @@
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// Confluence represents Confluence storage format, XHTML with each
+// section rendered as a table and code in 'code' macros.
+type Confluence struct {
+	settings *print.Settings
+}
+
+// NewConfluence returns new instance of Confluence.
+func NewConfluence(settings *print.Settings) *Confluence {
+	return &Confluence{
+		settings: settings,
+	}
+}
+
+// Print prints a Terraform module in Confluence storage format.
+func (c *Confluence) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	c.settings = settings
+	buffer := bytes.NewBufferString("")
+
+	for _, section := range settings.OrderedSections() {
+		switch section {
+		case "header":
+			if settings.ShowHeader && module.Header != "" {
+				buffer.WriteString(strings.Join(c.blocks(module.Header), "\n") + "\n")
+			}
+		case "usage":
+			if !settings.ShowUsage || module.Usage == "" {
+				continue
+			}
+			c.heading(buffer, "usage", "Usage")
+			buffer.WriteString(codeMacro(module.Usage, "hcl") + "\n")
+		case "requirements":
+			if !settings.ShowRequirements {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Requirements))
+			for _, requirement := range module.Requirements {
+				rows = append(rows, []string{c.literal(requirement.Name), c.literal(string(requirement.Version))})
+			}
+			c.section(buffer, "requirements", "Requirements", "No requirements.", []string{"Name", "Version"}, rows)
+		case "providers":
+			if !settings.ShowProviders {
+				continue
+			}
+			columns := []string{"Name"}
+			if settings.ShowProviderSources {
+				columns = append(columns, "Source")
+			}
+			columns = append(columns, "Version")
+			if settings.ShowLockedVersions {
+				columns = append(columns, "Locked")
+			}
+			rows := make([][]string, 0, len(module.Providers))
+			for _, provider := range module.Providers {
+				row := []string{c.literal(provider.FullName())}
+				if settings.ShowProviderSources {
+					row = append(row, c.literal(string(provider.Source)))
+				}
+				row = append(row, c.literal(string(provider.Version)))
+				if settings.ShowLockedVersions {
+					row = append(row, c.literal(string(provider.Locked)))
+				}
+				rows = append(rows, row)
+			}
+			c.section(buffer, "providers", "Providers", "No provider.", columns, rows)
+		case "modules":
+			if !settings.ShowModules {
+				continue
+			}
+			rows := make([][]string, 0, len(module.ModuleCalls))
+			for _, call := range module.ModuleCalls {
+				rows = append(rows, []string{c.literal(call.Name), c.literal(call.Source), c.literal(string(call.Version))})
+			}
+			c.section(buffer, "modules", "Modules", "No module.", []string{"Name", "Source", "Version"}, rows)
+		case "resources":
+			if !settings.ShowResources {
+				continue
+			}
+			c.section(buffer, "resources", "Resources", "No resource.", []string{"Type", "Name", "Provider"}, c.resources(module.ManagedResources()))
+		case "data-sources":
+			if !settings.ShowDataSources {
+				continue
+			}
+			c.section(buffer, "data-sources", "Data Sources", "No data source.", []string{"Type", "Name", "Provider"}, c.resources(module.DataResources()))
+		case "inputs":
+			if !settings.ShowInputs {
+				continue
+			}
+			c.section(buffer, "inputs", "Inputs", "No input.", c.inputColumns(module), c.inputs(module))
+		case "outputs":
+			if !settings.ShowOutputs {
+				continue
+			}
+			columns := []string{"Name", "Description"}
+			if settings.OutputValues {
+				columns = append(columns, "Value")
+				if settings.ShowSensitivity {
+					columns = append(columns, "Sensitive")
+				}
+			}
+			rows := make([][]string, 0, len(module.Outputs))
+			for _, output := range module.Outputs {
+				row := []string{c.literal(output.Name), c.text(string(output.Description))}
+				if settings.OutputValues {
+					value := output.GetValue()
+					if output.Sensitive {
+						value = "<sensitive>"
+					}
+					row = append(row, c.literal(value))
+					if settings.ShowSensitivity {
+						row = append(row, c.yesno(output.Sensitive))
+					}
+				}
+				rows = append(rows, row)
+			}
+			c.section(buffer, "outputs", "Outputs", "No output.", columns, rows)
+		case "checks":
+			if !settings.ShowChecks {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Checks))
+			for _, check := range module.Checks {
+				assertions := make([]string, 0, len(check.Assertions))
+				for _, assertion := range check.Assertions {
+					assertions = append(assertions, c.literal(strings.Join(strings.Fields(assertion.Condition), " "))+": "+xhtmlEscape(assertion.ErrorMessage))
+				}
+				rows = append(rows, []string{c.literal(check.Name), strings.Join(assertions, "<br />")})
+			}
+			c.section(buffer, "checks", "Checks", "No check.", []string{"Name", "Assertions"}, rows)
+		case "moved":
+			if !settings.ShowMoved {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Moved))
+			for _, moved := range module.Moved {
+				rows = append(rows, []string{c.literal(moved.From), c.literal(moved.To)})
+			}
+			c.section(buffer, "moved", "Moved", "No moved block.", []string{"From", "To"}, rows)
+		case "imports":
+			if !settings.ShowImports {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Imports))
+			for _, i := range module.Imports {
+				rows = append(rows, []string{c.literal(i.To), c.literal(i.ID)})
+			}
+			c.section(buffer, "imports", "Imports", "No import block.", []string{"To", "ID"}, rows)
+		case "footer":
+			if settings.ShowFooter && module.Footer != "" {
+				buffer.WriteString(strings.Join(c.blocks(module.Footer), "\n") + "\n")
+			}
+		}
+	}
+
+	if settings.ShowMeta {
+		meta := newMeta(settings)
+		line := "Generated by terraform-docs " + meta.Version
+		if meta.Timestamp != "" {
+			line += " on " + meta.Timestamp
+		}
+		buffer.WriteString("<p>" + xhtmlEscape(line) + "</p>\n")
+	}
+
+	return buffer.String(), nil
+}
+
+func (c *Confluence) inputColumns(module *tfconf.Module) []string {
+	columns := []string{"Name", "Description"}
+	if c.showColumn("type") {
+		columns = append(columns, "Type")
+	}
+	if c.showColumn("default") {
+		columns = append(columns, "Default")
+	}
+	if c.settings.OutputValues && c.settings.ShowInputValues {
+		columns = append(columns, "Value")
+	}
+	if c.settings.ShowRequired {
+		columns = append(columns, "Required")
+	}
+	if c.settings.ShowSensitivity && module.HasSensitiveInputs() {
+		columns = append(columns, "Sensitive")
+	}
+	return columns
+}
+
+func (c *Confluence) inputs(module *tfconf.Module) [][]string {
+	rows := make([][]string, 0, len(module.Inputs))
+	for _, input := range module.Inputs {
+		row := []string{c.literal(input.Name), c.text(string(input.Description))}
+		if c.showColumn("type") {
+			row = append(row, c.literal(truncate(string(input.Type), c.settings.TypeMaxLength)))
+		}
+		if c.showColumn("default") {
+			value := c.literal(input.GetValue())
+			if c.settings.MarkMissingDefaults {
+				if marker := input.GetDefaultMarker(c.settings.ShowRequired); marker != "" {
+					value = xhtmlEscape(marker)
+				}
+			}
+			row = append(row, value)
+		}
+		if c.settings.OutputValues && c.settings.ShowInputValues {
+			row = append(row, c.literal(input.GetActualValue()))
+		}
+		if c.settings.ShowRequired {
+			row = append(row, c.yesno(input.Required))
+		}
+		if c.settings.ShowSensitivity && module.HasSensitiveInputs() {
+			row = append(row, c.yesno(input.Sensitive))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (c *Confluence) resources(resources []*tfconf.Resource) [][]string {
+	rows := make([][]string, 0, len(resources))
+	for _, resource := range resources {
+		rows = append(rows, []string{c.literal(resource.FullType()), c.literal(resource.Name), c.literal(resource.Provider)})
+	}
+	return rows
+}
+
+// section writes the heading of section 'name' followed by a table of
+// 'rows', or by 'empty' paragraph if there's no row to show.
+func (c *Confluence) section(buffer *bytes.Buffer, name string, title string, empty string, columns []string, rows [][]string) {
+	c.heading(buffer, name, title)
+	if len(rows) == 0 {
+		buffer.WriteString("<p>" + empty + "</p>\n")
+		return
+	}
+	buffer.WriteString("<table>\n<tbody>\n")
+	buffer.WriteString("<tr>")
+	for _, column := range columns {
+		buffer.WriteString("<th>" + column + "</th>")
+	}
+	buffer.WriteString("</tr>\n")
+	for _, row := range rows {
+		buffer.WriteString("<tr>")
+		for _, cell := range row {
+			buffer.WriteString("<td>" + cell + "</td>")
+		}
+		buffer.WriteString("</tr>\n")
+	}
+	buffer.WriteString("</tbody>\n</table>\n")
+}
+
+// heading writes the heading of section 'name', with its custom title if
+// set, at the base heading level unless headings are hidden.
+func (c *Confluence) heading(buffer *bytes.Buffer, name string, title string) {
+	if t := c.settings.SectionTitles[name]; t != "" {
+		title = t
+	}
+	if c.settings.HideHeadings {
+		return
+	}
+	level := headingBaseLevel(c.settings)
+	buffer.WriteString(fmt.Sprintf("<h%d>%s</h%d>\n", level, xhtmlEscape(title), level))
+}
+
+// literal returns 's' as inline code, or in a 'code' macro if it spans
+// multiple lines.
+func (c *Confluence) literal(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return "n/a"
+	case strings.Contains(s, "\n"):
+		return codeMacro(s, "hcl")
+	}
+	return "<code>" + xhtmlEscape(s) + "</code>"
+}
+
+// text returns 's' converted to XHTML to fit in a table cell, as is if
+// it's a single paragraph, or "n/a" if it's empty.
+func (c *Confluence) text(s string) string {
+	blocks := c.blocks(s)
+	switch {
+	case len(blocks) == 0:
+		return "n/a"
+	case len(blocks) == 1 && strings.HasPrefix(blocks[0], "<p>"):
+		return strings.TrimSuffix(strings.TrimPrefix(blocks[0], "<p>"), "</p>")
+	}
+	return strings.Join(blocks, "")
+}
+
+// blocks returns paragraphs of 's' wrapped in '<p>', with their lines
+// separated by '<br />', and its markdown code blocks converted to 'code'
+// macros. Code spans are converted to '<code>' and the rest gets escaped.
+func (c *Confluence) blocks(s string) []string {
+	blocks := make([]string, 0)
+	paragraph := make([]string, 0)
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, "<p>"+strings.Join(paragraph, "<br />")+"</p>")
+			paragraph = paragraph[:0]
+		}
+	}
+	var code []string
+	var language string
+	for _, line := range strings.Split(strings.TrimSpace(s), "\n") {
+		fence := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(fence, "```") && code == nil:
+			flush()
+			code = make([]string, 0)
+			language = strings.TrimPrefix(fence, "```")
+		case strings.HasPrefix(fence, "```"):
+			blocks = append(blocks, codeMacro(strings.Join(code, "\n"), language))
+			code = nil
+		case code != nil:
+			code = append(code, line)
+		case fence == "":
+			flush()
+		default:
+			paragraph = append(paragraph, c.inline(fence))
+		}
+	}
+	if code != nil {
+		blocks = append(blocks, codeMacro(strings.Join(code, "\n"), language))
+	}
+	flush()
+	return blocks
+}
+
+// inline converts markdown code spans of 'line' to '<code>' and escapes
+// the rest of it.
+func (c *Confluence) inline(line string) string {
+	segments := strings.Split(line, "`")
+	if len(segments)%2 == 0 {
+		// unbalanced backticks, there's no code span to convert
+		segments = []string{line}
+	}
+	for i, segment := range segments {
+		if i%2 == 1 && segment != "" {
+			segments[i] = "<code>" + xhtmlEscape(segment) + "</code>"
+		} else {
+			segments[i] = xhtmlEscape(segment)
+		}
+	}
+	return strings.Join(segments, "")
+}
+
+func (c *Confluence) yesno(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func (c *Confluence) showColumn(column string) bool {
+	for _, h := range c.settings.HiddenColumns {
+		if h == column {
+			return false
+		}
+	}
+	return true
+}
+
+var xhtmlReplacer = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;")
+
+// xhtmlEscape escapes characters of 's' which aren't allowed in text of
+// XHTML as is.
+func xhtmlEscape(s string) string {
+	return xhtmlReplacer.Replace(s)
+}
+
+// codeMacro returns 'code' in a Confluence 'code' macro, highlighted as
+// 'language' if set. The content goes into CDATA, so it isn't escaped but
+// any ']]>' of it gets split across two sections.
+func codeMacro(code string, language string) string {
+	var b strings.Builder
+	b.WriteString(`<ac:structured-macro ac:name="code">`)
+	if language != "" {
+		b.WriteString(`<ac:parameter ac:name="language">` + xhtmlEscape(language) + `</ac:parameter>`)
+	}
+	b.WriteString("<ac:plain-text-body><![CDATA[")
+	b.WriteString(strings.Replace(code, "]]>", "]]]]><![CDATA[>", -1))
+	b.WriteString("]]></ac:plain-text-body></ac:structured-macro>")
+	return b.String()
+}
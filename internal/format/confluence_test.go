@@ -0,0 +1,683 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/module"
+	"github.com/segmentio/terraform-docs/internal/testutil"
+	"github.com/segmentio/terraform-docs/pkg/print"
+)
+
+func TestConfluence(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceWithRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowRequired: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-WithRequired")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceSortByRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName:     true,
+		SortByRequired: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-SortByRequired")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		SortBy: &module.SortBy{
+			Name:     true,
+			Required: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceNoHeader(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-NoHeader")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceNoInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-NoInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceNoOutputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-NoOutputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyHeader(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyHeader")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceHideHeadings(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		HideHeadings:     true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-HideHeadings")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyOutputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyOutputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyMoved(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyMoved")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowMoved: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyImports(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowImports:      true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyImports")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowImports: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOnlyChecks(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowChecks:       true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OnlyChecks")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowChecks: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceSectionsOrder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionsOrder: []string{"outputs", "inputs"},
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-SectionsOrder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceHeadingBaseLevel(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		HeadingBaseLevel: 1,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-HeadingBaseLevel")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOutputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OutputValues")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceOutputValuesNoSensitivity(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-OutputValuesNoSensitivity")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:    false,
+		ShowProviders: false,
+		ShowInputs:    false,
+		ShowOutputs:   false,
+	}).Build()
+
+	options, err := module.NewOptions().WithOverwrite(&module.Options{
+		HeaderFromFiles: []string{"bad.tf"},
+	})
+	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal("", actual)
+}
+
+func TestConfluenceWithFooter(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowFooter: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-WithFooter")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowFooter:     true,
+		FooterFromFile: "footer.md",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceWithUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowUsage: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-WithUsage")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowUsage:     true,
+		UsageFromFile: "examples/basic/main.tf",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceSectionTitles(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionTitles: map[string]string{
+			"inputs":  "Variables",
+			"outputs": "Exports",
+		},
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-SectionTitles")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceLockedVersions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowLockedVersions: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-LockedVersions")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowLockedVersions: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceProviderSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowProviderSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-ProviderSources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowProviderSources: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestConfluenceEscape(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "escape markup",
+			text:     "<b>bold</b> & \"quoted\"",
+			expected: "&lt;b&gt;bold&lt;/b&gt; &amp; &quot;quoted&quot;",
+		},
+		{
+			name:     "keep markdown characters",
+			text:     "some_value_here and *emphasis*",
+			expected: "some_value_here and *emphasis*",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := xhtmlEscape(tt.text)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestConfluenceCodeMacro(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     string
+		language string
+		expected string
+	}{
+		{
+			name:     "code with language",
+			code:     "{\n  foo = \"<bar>\"\n}",
+			language: "hcl",
+			expected: "<ac:structured-macro ac:name=\"code\"><ac:parameter ac:name=\"language\">hcl</ac:parameter><ac:plain-text-body><![CDATA[{\n  foo = \"<bar>\"\n}]]></ac:plain-text-body></ac:structured-macro>",
+		},
+		{
+			name:     "code without language",
+			code:     "foo",
+			language: "",
+			expected: "<ac:structured-macro ac:name=\"code\"><ac:plain-text-body><![CDATA[foo]]></ac:plain-text-body></ac:structured-macro>",
+		},
+		{
+			name:     "code with end of cdata",
+			code:     "a[b[0]]>c",
+			language: "",
+			expected: "<ac:structured-macro ac:name=\"code\"><ac:plain-text-body><![CDATA[a[b[0]]]]><![CDATA[>c]]></ac:plain-text-body></ac:structured-macro>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := codeMacro(tt.code, tt.language)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestConfluenceTypeMaxLength(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:    true,
+		TypeMaxLength: 20,
+	}).Build()
+
+	expected, err := testutil.GetExpected("confluence", "confluence-TypeMaxLength")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewConfluence(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
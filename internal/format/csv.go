@@ -0,0 +1,91 @@
+package format
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// CSV represents CSV format, with inputs and outputs combined
+// in one table and differentiated by 'Section' column.
+type CSV struct{}
+
+// NewCSV returns new instance of CSV.
+func NewCSV(settings *print.Settings) *CSV {
+	return &CSV{}
+}
+
+// Print prints a Terraform module as csv.
+func (c *CSV) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	records := [][]string{
+		c.columns([]string{"Section", "Name", "Type", "Default", "Required", "Sensitive", "Description"}, settings),
+	}
+
+	if settings.ShowInputs {
+		for _, input := range module.Inputs {
+			value := input.GetValue()
+			if settings.MarkMissingDefaults && !input.HasDefault() {
+				value = input.GetDefaultMarker(settings.ShowRequired)
+			}
+			records = append(records, c.columns([]string{
+				"input",
+				input.Name,
+				string(input.Type),
+				value,
+				strconv.FormatBool(input.Required),
+				strconv.FormatBool(input.Sensitive),
+				string(input.Description),
+			}, settings))
+		}
+	}
+	if settings.ShowOutputs {
+		for _, output := range module.Outputs {
+			value := output.GetValue()
+			sensitive := ""
+			if settings.OutputValues {
+				sensitive = strconv.FormatBool(output.Sensitive)
+				if output.Sensitive {
+					value = "<sensitive>"
+				}
+			}
+			records = append(records, c.columns([]string{
+				"output",
+				output.Name,
+				"",
+				value,
+				"",
+				sensitive,
+				string(output.Description),
+			}, settings))
+		}
+	}
+
+	buffer := new(bytes.Buffer)
+
+	writer := csv.NewWriter(buffer)
+	if err := writer.WriteAll(records); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// columns removes 'Required' and 'Sensitive' columns of a
+// record if they are not supposed to be shown
+func (c *CSV) columns(record []string, settings *print.Settings) []string {
+	columns := make([]string, 0, len(record))
+	for i, column := range record {
+		if i == 4 && !settings.ShowRequired {
+			continue
+		}
+		if i == 5 && !settings.ShowSensitivity {
+			continue
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
@@ -0,0 +1,155 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// change is a property of an input which differs between two versions of
+// a module
+type change struct {
+	Name     string
+	Property string
+	Before   string
+	After    string
+}
+
+// RenderDiff renders the inputs added, removed or changed (i.e. their type,
+// default, requirement or sensitivity) and the outputs added or removed from
+// module 'before' to module 'after' as a Markdown document, with a table for
+// each kind of them.
+func RenderDiff(before *tfconf.Module, after *tfconf.Module, settings *print.Settings) string {
+	level := headingBaseLevel(settings)
+	heading := strings.Repeat("#", level)
+	subheading := strings.Repeat("#", level+1)
+
+	var buf strings.Builder
+	printTable := func(title string, columns []string, rows [][]string) {
+		if len(rows) == 0 {
+			return
+		}
+		fmt.Fprintf(&buf, "%s %s\n\n", subheading, title)
+		fmt.Fprintf(&buf, "| %s |\n", strings.Join(columns, " | "))
+		separators := make([]string, len(columns))
+		for i, column := range columns {
+			separators[i] = strings.Repeat("-", len(column))
+		}
+		fmt.Fprintf(&buf, "|-%s-|\n", strings.Join(separators, "-|-"))
+		for _, row := range rows {
+			fmt.Fprintf(&buf, "| %s |\n", strings.Join(row, " | "))
+		}
+		buf.WriteString("\n")
+	}
+
+	// inputs
+	fmt.Fprintf(&buf, "%s Inputs\n\n", heading)
+	added, removed, changed := diffInputs(before.Inputs, after.Inputs, settings)
+	if len(added)+len(removed)+len(changed) == 0 {
+		buf.WriteString("No change of inputs.\n\n")
+	}
+	printTable("Added", []string{"Name", "Type", "Default"}, added)
+	printTable("Removed", []string{"Name", "Type", "Default"}, removed)
+	rows := make([][]string, 0, len(changed))
+	for _, c := range changed {
+		rows = append(rows, []string{c.Name, c.Property, c.Before, c.After})
+	}
+	printTable("Changed", []string{"Name", "Change", "Before", "After"}, rows)
+
+	// outputs
+	fmt.Fprintf(&buf, "%s Outputs\n\n", heading)
+	added, removed = diffOutputs(before.Outputs, after.Outputs, settings)
+	if len(added)+len(removed) == 0 {
+		buf.WriteString("No change of outputs.\n\n")
+	}
+	printTable("Added", []string{"Name", "Description"}, added)
+	printTable("Removed", []string{"Name", "Description"}, removed)
+
+	return sanitize(strings.TrimSuffix(buf.String(), "\n"))
+}
+
+// diffInputs returns rows of inputs only in 'after' and only in 'before', in
+// their order, and the changes of the ones in both.
+func diffInputs(before []*tfconf.Input, after []*tfconf.Input, settings *print.Settings) ([][]string, [][]string, []*change) {
+	row := func(i *tfconf.Input) []string {
+		return []string{i.Name, diffCell(string(i.Type), settings), diffCell(i.GetValue(), settings)}
+	}
+	old := make(map[string]*tfconf.Input, len(before))
+	for _, i := range before {
+		old[i.Name] = i
+	}
+	current := make(map[string]bool, len(after))
+
+	added := [][]string{}
+	changed := []*change{}
+	for _, i := range after {
+		current[i.Name] = true
+		o, ok := old[i.Name]
+		if !ok {
+			added = append(added, row(i))
+			continue
+		}
+		if o.Type != i.Type {
+			changed = append(changed, &change{i.Name, "type", diffCell(string(o.Type), settings), diffCell(string(i.Type), settings)})
+		}
+		if o.GetValue() != i.GetValue() {
+			changed = append(changed, &change{i.Name, "default", diffCell(o.GetValue(), settings), diffCell(i.GetValue(), settings)})
+		}
+		if o.Required != i.Required {
+			changed = append(changed, &change{i.Name, "required", fmt.Sprint(o.Required), fmt.Sprint(i.Required)})
+		}
+		if o.Sensitive != i.Sensitive {
+			changed = append(changed, &change{i.Name, "sensitive", fmt.Sprint(o.Sensitive), fmt.Sprint(i.Sensitive)})
+		}
+	}
+	removed := [][]string{}
+	for _, i := range before {
+		if !current[i.Name] {
+			removed = append(removed, row(i))
+		}
+	}
+	return added, removed, changed
+}
+
+// diffOutputs returns rows of outputs only in 'after' and only in 'before',
+// in their order
+func diffOutputs(before []*tfconf.Output, after []*tfconf.Output, settings *print.Settings) ([][]string, [][]string) {
+	row := func(o *tfconf.Output) []string {
+		description := strings.Join(strings.Fields(string(o.Description)), " ")
+		if description == "" {
+			description = settings.Placeholder
+		}
+		return []string{o.Name, strings.Replace(description, "|", "\\|", -1)}
+	}
+	old := make(map[string]bool, len(before))
+	for _, o := range before {
+		old[o.Name] = true
+	}
+	current := make(map[string]bool, len(after))
+
+	added := [][]string{}
+	for _, o := range after {
+		current[o.Name] = true
+		if !old[o.Name] {
+			added = append(added, row(o))
+		}
+	}
+	removed := [][]string{}
+	for _, o := range before {
+		if !current[o.Name] {
+			removed = append(removed, row(o))
+		}
+	}
+	return added, removed
+}
+
+// diffCell returns 'value' as inline code fitting in one table cell, or the
+// placeholder if it's empty
+func diffCell(value string, settings *print.Settings) string {
+	if strings.TrimSpace(value) == "" {
+		return settings.Placeholder
+	}
+	return strings.Replace(printInlineCode(value), "|", "\\|", -1)
+}
@@ -0,0 +1,68 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/types"
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+func TestRenderDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		before   *tfconf.Module
+		after    *tfconf.Module
+		expected string
+	}{
+		{
+			name: "no change",
+			before: &tfconf.Module{
+				Inputs:  []*tfconf.Input{{Name: "name", Type: types.String("string"), Required: true}},
+				Outputs: []*tfconf.Output{{Name: "id"}},
+			},
+			after: &tfconf.Module{
+				Inputs:  []*tfconf.Input{{Name: "name", Type: types.String("string"), Required: true}},
+				Outputs: []*tfconf.Output{{Name: "id"}},
+			},
+			expected: "## Inputs\n\nNo change of inputs.\n\n## Outputs\n\nNo change of outputs.\n",
+		},
+		{
+			name: "changed type",
+			before: &tfconf.Module{
+				Inputs: []*tfconf.Input{{Name: "mode", Type: types.String("string"), Required: true}},
+			},
+			after: &tfconf.Module{
+				Inputs: []*tfconf.Input{{Name: "mode", Type: types.String("object({ a = string })"), Required: true, Sensitive: true}},
+			},
+			expected: "## Inputs\n\n" +
+				"### Changed\n\n" +
+				"| Name | Change | Before | After |\n" +
+				"|------|--------|--------|-------|\n" +
+				"| mode | type | `string` | `object({ a = string })` |\n" +
+				"| mode | sensitive | false | true |\n\n" +
+				"## Outputs\n\nNo change of outputs.\n",
+		},
+		{
+			name:   "escaped pipes",
+			before: &tfconf.Module{},
+			after: &tfconf.Module{
+				Outputs: []*tfconf.Output{{Name: "mode", Description: types.String("Either a | b.")}},
+			},
+			expected: "## Inputs\n\nNo change of inputs.\n\n" +
+				"## Outputs\n\n" +
+				"### Added\n\n" +
+				"| Name | Description |\n" +
+				"|------|-------------|\n" +
+				"| mode | Either a \\| b. |\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, RenderDiff(tt.before, tt.after, print.NewSettings()))
+		})
+	}
+}
@@ -18,8 +18,16 @@ func Factory(name string, settings *print.Settings) (print.Format, error) {
 		return NewAsciidocDocument(settings), nil
 	case "asciidoc table", "asciidoc tbl", "adoc table", "adoc tbl":
 		return NewAsciidocTable(settings), nil
+	case "confluence":
+		return NewConfluence(settings), nil
+	case "csv":
+		return NewCSV(settings), nil
 	case "json":
 		return NewJSON(settings), nil
+	case "json schema":
+		return NewJSONSchema(settings), nil
+	case "jsonl":
+		return NewJSONLines(settings), nil
 	case "markdown", "md":
 		return NewTable(settings), nil
 	case "markdown document", "markdown doc", "md document", "md doc":
@@ -28,6 +36,10 @@ func Factory(name string, settings *print.Settings) (print.Format, error) {
 		return NewTable(settings), nil
 	case "pretty":
 		return NewPretty(settings), nil
+	case "rst":
+		return NewRST(settings), nil
+	case "template":
+		return NewTemplate(settings)
 	case "tfvars hcl":
 		return NewTfvarsHCL(settings), nil
 	case "tfvars json":
@@ -6,7 +6,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/segmentio/terraform-docs/internal/types"
 	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
 
 func TestFormatFactory(t *testing.T) {
@@ -76,12 +78,36 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.AsciidocTable",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "confluence",
+			expected: "*format.Confluence",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "csv",
+			expected: "*format.CSV",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "json",
 			expected: "*format.JSON",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "json schema",
+			expected: "*format.JSONSchema",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "jsonl",
+			expected: "*format.JSONLines",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "markdown",
@@ -148,6 +174,18 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.Pretty",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "rst",
+			expected: "*format.RST",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "template",
+			expected: "*format.Template",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "tfvars hcl",
@@ -199,3 +237,78 @@ func TestFormatFactory(t *testing.T) {
 		})
 	}
 }
+
+func TestStructuredFormatsMapKeyOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:   "json keys of map in sorted order",
+			format: "json",
+			expected: `      "default": {
+        "alpha": "a",
+        "charlie": "c",
+        "mike": {
+          "bravo": "b",
+          "yankee": "y"
+        },
+        "zulu": "z"
+      },`,
+		},
+		{
+			name:   "yaml keys of map in sorted order",
+			format: "yaml",
+			expected: `    default:
+      alpha: a
+      charlie: c
+      mike:
+        bravo: b
+        yankee: "y"
+      zulu: z
+`,
+		},
+		{
+			name:   "toml keys of map in sorted order",
+			format: "toml",
+			expected: `  [inputs.default]
+    alpha = "a"
+    charlie = "c"
+    zulu = "z"
+    [inputs.default.mike]
+      bravo = "b"
+      yankee = "y"
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			module := &tfconf.Module{
+				Inputs: []*tfconf.Input{
+					{
+						Name: "tags",
+						Type: "map(any)",
+						Default: types.ValueOf(map[string]interface{}{
+							"zulu":    "z",
+							"mike":    map[string]interface{}{"yankee": "y", "bravo": "b"},
+							"alpha":   "a",
+							"charlie": "c",
+						}),
+					},
+				},
+			}
+			settings := print.NewSettings()
+
+			printer, err := Factory(tt.format, settings)
+			assert.Nil(err)
+
+			actual, err := printer.Print(module, settings)
+
+			assert.Nil(err)
+			assert.Contains(actual, tt.expected)
+		})
+	}
+}
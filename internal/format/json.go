@@ -12,6 +12,35 @@ import (
 // JSON represents JSON format.
 type JSON struct{}
 
+// jsonPartitioned is the module whose outputs are grouped by
+// their sensitivity, see 'settings.PartitionSensitiveOutputs'
+type jsonPartitioned struct {
+	*tfconf.Module
+	Outputs *jsonOutputs `json:"outputs"`
+}
+
+type jsonOutputs struct {
+	Sensitive []*tfconf.Output `json:"sensitive"`
+	Public    []*tfconf.Output `json:"public"`
+}
+
+// partitionOutputs groups 'outputs' into sensitive and public ones, in
+// their original order.
+func partitionOutputs(outputs []*tfconf.Output) *jsonOutputs {
+	partitioned := &jsonOutputs{
+		Sensitive: make([]*tfconf.Output, 0),
+		Public:    make([]*tfconf.Output, 0),
+	}
+	for _, output := range outputs {
+		if output.Sensitive {
+			partitioned.Sensitive = append(partitioned.Sensitive, output)
+		} else {
+			partitioned.Public = append(partitioned.Public, output)
+		}
+	}
+	return partitioned
+}
+
 // NewJSON returns new instance of JSON.
 func NewJSON(settings *print.Settings) *JSON {
 	return &JSON{}
@@ -21,15 +50,24 @@ func NewJSON(settings *print.Settings) *JSON {
 func (j *JSON) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
 	copy := &tfconf.Module{
 		Header:       "",
+		Footer:       "",
 		Inputs:       make([]*tfconf.Input, 0),
 		Outputs:      make([]*tfconf.Output, 0),
 		Providers:    make([]*tfconf.Provider, 0),
 		Requirements: make([]*tfconf.Requirement, 0),
+		Resources:    make([]*tfconf.Resource, 0),
+		ModuleCalls:  make([]*tfconf.ModuleCall, 0),
 	}
 
 	if settings.ShowHeader {
 		copy.Header = module.Header
 	}
+	if settings.ShowFooter {
+		copy.Footer = module.Footer
+	}
+	if settings.ShowUsage {
+		copy.Usage = module.Usage
+	}
 	if settings.ShowInputs {
 		copy.Inputs = module.Inputs
 	}
@@ -42,14 +80,42 @@ func (j *JSON) Print(module *tfconf.Module, settings *print.Settings) (string, e
 	if settings.ShowRequirements {
 		copy.Requirements = module.Requirements
 	}
+	if settings.ShowResources || settings.ShowDataSources {
+		copy.Resources = filterResources(module, settings)
+	}
+	if settings.ShowModules {
+		copy.ModuleCalls = module.ModuleCalls
+	}
+	if settings.ShowChecks {
+		copy.Checks = module.Checks
+	}
+	if settings.ShowMoved {
+		copy.Moved = module.Moved
+	}
+	if settings.ShowImports {
+		copy.Imports = module.Imports
+	}
+	if settings.ShowMeta {
+		copy.Meta = newMeta(settings)
+	}
 
 	buffer := new(bytes.Buffer)
 
 	encoder := json.NewEncoder(buffer)
-	encoder.SetIndent("", "  ")
-	encoder.SetEscapeHTML(settings.EscapeCharacters)
+	if !settings.Compact {
+		encoder.SetIndent("", strings.Repeat(" ", settings.IndentLevel))
+	}
+	encoder.SetEscapeHTML(settings.EscapeCharacters())
+
+	var document interface{} = copy
+	if settings.PartitionSensitiveOutputs {
+		document = &jsonPartitioned{
+			Module:  copy,
+			Outputs: partitionOutputs(copy.Outputs),
+		}
+	}
 
-	err := encoder.Encode(copy)
+	err := encoder.Encode(document)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,135 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/internal/types"
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+var (
+	typeOfString = reflect.TypeOf(types.String(""))
+	typeOfValue  = reflect.TypeOf((*types.Value)(nil)).Elem()
+)
+
+// JSONSchema represents JSON Schema format, which describes
+// the structure of the document generated by JSON format.
+type JSONSchema struct{}
+
+type jsonschema struct {
+	Schema      string                 `json:"$schema,omitempty"`
+	Title       string                 `json:"title,omitempty"`
+	Type        interface{}            `json:"type,omitempty"`
+	Properties  map[string]*jsonschema `json:"properties,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Items       *jsonschema            `json:"items,omitempty"`
+	Additionals *bool                  `json:"additionalProperties,omitempty"`
+}
+
+// NewJSONSchema returns new instance of JSONSchema.
+func NewJSONSchema(settings *print.Settings) *JSONSchema {
+	return &JSONSchema{}
+}
+
+// Print prints JSON Schema of a Terraform module document.
+func (j *JSONSchema) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	schema := reflectJSONSchema(reflect.TypeOf(tfconf.Module{}))
+	schema.Schema = jsonSchemaDraft
+	schema.Title = "terraform-docs module"
+
+	// 'value' and 'sensitive' of outputs are always present when
+	// output values are shown (see tfconf.Output.MarshalJSON)
+	if settings.OutputValues {
+		outputs := schema.Properties["outputs"].Items
+		outputs.Required = append(outputs.Required, "value", "sensitive")
+	}
+	if settings.PartitionSensitiveOutputs {
+		outputs := schema.Properties["outputs"]
+		partitioned := reflectJSONSchema(reflect.TypeOf(jsonOutputs{}))
+		partitioned.Properties["sensitive"] = outputs
+		partitioned.Properties["public"] = outputs
+		schema.Properties["outputs"] = partitioned
+	}
+
+	buffer := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buffer)
+	if !settings.Compact {
+		encoder.SetIndent("", strings.Repeat(" ", settings.IndentLevel))
+	}
+	encoder.SetEscapeHTML(settings.EscapeCharacters())
+
+	err := encoder.Encode(schema)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// reflectJSONSchema builds the schema of type 't' based on the 'json'
+// tags of its fields, the same way encoding/json marshals the type.
+func reflectJSONSchema(t reflect.Type) *jsonschema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch {
+	case t == typeOfString:
+		return &jsonschema{Type: []string{"string", "null"}}
+	case t == typeOfValue:
+		return &jsonschema{} // any value
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &jsonschema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &jsonschema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonschema{Type: "number"}
+	case reflect.String:
+		return &jsonschema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		return &jsonschema{Type: "array", Items: reflectJSONSchema(t.Elem())}
+	case reflect.Struct:
+		additionals := false
+		schema := &jsonschema{
+			Type:        "object",
+			Properties:  make(map[string]*jsonschema),
+			Required:    make([]string, 0),
+			Additionals: &additionals,
+		}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			if field.PkgPath != "" || tag == "-" {
+				continue
+			}
+			segments := strings.Split(tag, ",")
+			name := segments[0]
+			if name == "" {
+				name = field.Name
+			}
+			schema.Properties[name] = reflectJSONSchema(field.Type)
+			if !contains(segments[1:], "omitempty") {
+				schema.Required = append(schema.Required, name)
+			}
+		}
+		return schema
+	}
+	return &jsonschema{}
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,128 @@
+package format
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/module"
+	"github.com/segmentio/terraform-docs/internal/testutil"
+	"github.com/segmentio/terraform-docs/pkg/print"
+)
+
+func TestJSONSchema(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("json", "schema")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSONSchema(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJSONSchemaOutputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "schema-OutputValues")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSONSchema(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJSONSchemaPartitionSensitiveOutputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:              true,
+		PartitionSensitiveOutputs: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "schema-PartitionSensitiveOutputs")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSONSchema(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJSONSchemaMatchesJSON(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues: true,
+	}).Build()
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	document, err := NewJSON(settings).Print(module, settings)
+	assert.Nil(err)
+
+	schema, err := NewJSONSchema(settings).Print(module, settings)
+	assert.Nil(err)
+
+	var doc map[string]interface{}
+	assert.Nil(json.Unmarshal([]byte(document), &doc))
+
+	var sch jsonschema
+	assert.Nil(json.Unmarshal([]byte(schema), &sch))
+
+	assert.ElementsMatch(keysOf(doc), sch.Required)
+	for key, value := range doc {
+		items, ok := value.([]interface{})
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			assert.ElementsMatch(keysOf(item.(map[string]interface{})), sch.Properties[key].Items.Required, key)
+		}
+	}
+}
+
+func keysOf(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
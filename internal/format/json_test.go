@@ -7,6 +7,7 @@ import (
 
 	"github.com/segmentio/terraform-docs/internal/module"
 	"github.com/segmentio/terraform-docs/internal/testutil"
+	"github.com/segmentio/terraform-docs/internal/version"
 	"github.com/segmentio/terraform-docs/pkg/print"
 )
 
@@ -111,11 +112,14 @@ func TestJsonSortByType(t *testing.T) {
 func TestJsonNoHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-NoHeader")
@@ -135,11 +139,14 @@ func TestJsonNoHeader(t *testing.T) {
 func TestJsonNoInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-NoInputs")
@@ -159,11 +166,14 @@ func TestJsonNoInputs(t *testing.T) {
 func TestJsonNoOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-NoOutputs")
@@ -183,11 +193,14 @@ func TestJsonNoOutputs(t *testing.T) {
 func TestJsonNoProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-NoProviders")
@@ -207,11 +220,14 @@ func TestJsonNoProviders(t *testing.T) {
 func TestJsonNoRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-NoRequirements")
@@ -228,14 +244,98 @@ func TestJsonNoRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestJsonNoResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-NoResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonNoDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-NoDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonNoModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-NoModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestJsonOnlyHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-OnlyHeader")
@@ -255,11 +355,14 @@ func TestJsonOnlyHeader(t *testing.T) {
 func TestJsonOnlyInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-OnlyInputs")
@@ -279,11 +382,14 @@ func TestJsonOnlyInputs(t *testing.T) {
 func TestJsonOnlyOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-OnlyOutputs")
@@ -303,11 +409,14 @@ func TestJsonOnlyOutputs(t *testing.T) {
 func TestJsonOnlyProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-OnlyProviders")
@@ -327,11 +436,14 @@ func TestJsonOnlyProviders(t *testing.T) {
 func TestJsonOnlyRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-OnlyRequirements")
@@ -348,10 +460,227 @@ func TestJsonOnlyRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestJsonOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OnlyResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonOnlyDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OnlyDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonOnlyMoved(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OnlyMoved")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowMoved: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonOnlyImports(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowImports:      true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OnlyImports")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowImports: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonSensitiveInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:      true,
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-SensitiveInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetTestModule("sensitive-inputs", options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonOnlyChecks(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowChecks:       true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OnlyChecks")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowChecks: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonShowMeta(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowMeta: true,
+	}).Build()
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Contains(actual, "\"meta\": {\n    \"version\": \""+version.Short()+"\"\n  }")
+}
+
+func TestJsonOnlyModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-OnlyModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestJsonEscapeCharacters(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		EscapeCharacters: true,
+		EscapeMode: "markdown",
 	}).Build()
 
 	expected, err := testutil.GetExpected("json", "json-EscapeCharacters")
@@ -378,8 +707,8 @@ func TestJsonOutputValues(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
 	})
 	assert.Nil(err)
 
@@ -393,6 +722,142 @@ func TestJsonOutputValues(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestJsonPartitionSensitiveOutputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:              true,
+		PartitionSensitiveOutputs: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-PartitionSensitiveOutputs")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonPartitionSensitiveOutputsDeclared(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		PartitionSensitiveOutputs: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-PartitionSensitiveOutputsDeclared")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetTestModule("sensitive-outputs", options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonShowNullable(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowNullable: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-ShowNullable")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowNullable: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonShowValidation(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowValidation: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-ShowValidation")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowValidation: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonIndent4(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowOutputs: true,
+		IndentLevel: 4,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-Indent4")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonCompact(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowOutputs: true,
+		Compact:     true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-Compact")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestJsonHeaderFromFile(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -429,7 +894,7 @@ func TestJsonHeaderFromFile(t *testing.T) {
 			assert.Nil(err)
 
 			options, err := module.NewOptions().WithOverwrite(&module.Options{
-				HeaderFromFile: tt.file,
+				HeaderFromFiles: []string{tt.file},
 			})
 			assert.Nil(err)
 
@@ -458,7 +923,7 @@ func TestJsonEmpty(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().WithOverwrite(&module.Options{
-		HeaderFromFile: "bad.tf",
+		HeaderFromFiles: []string{"bad.tf"},
 	})
 	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
 	assert.Nil(err)
@@ -472,3 +937,53 @@ func TestJsonEmpty(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal(expected, actual)
 }
+
+func TestJsonWithFooter(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowFooter: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-WithFooter")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowFooter:     true,
+		FooterFromFile: "footer.md",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJsonWithUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowUsage: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json", "json-WithUsage")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowUsage:     true,
+		UsageFromFile: "examples/basic/main.tf",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSON(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
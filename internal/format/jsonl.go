@@ -0,0 +1,22 @@
+package format
+
+import (
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// JSONLines represents JSON Lines format, where the document of a module
+// takes exactly one line so modules can be streamed one after the other.
+type JSONLines struct{}
+
+// NewJSONLines returns new instance of JSONLines.
+func NewJSONLines(settings *print.Settings) *JSONLines {
+	return &JSONLines{}
+}
+
+// Print prints a Terraform module as json on one line.
+func (j *JSONLines) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	compact := *settings
+	compact.Compact = true
+	return NewJSON(&compact).Print(module, &compact)
+}
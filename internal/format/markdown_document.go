@@ -1,8 +1,12 @@
 package format
 
 import (
+	"fmt"
+	"strings"
 	"text/template"
+	"unicode/utf8"
 
+	"github.com/segmentio/terraform-docs/internal/types"
 	"github.com/segmentio/terraform-docs/pkg/print"
 	"github.com/segmentio/terraform-docs/pkg/tfconf"
 	"github.com/segmentio/terraform-docs/pkg/tmpl"
@@ -18,16 +22,68 @@ const (
 	{{ end -}}
 	`
 
+	documentTOCTpl = `
+	{{- if .Settings.ShowTOC -}}
+		‡‡‡TOC‡‡‡
+		{{ printf "\n" }}
+	{{- end -}}
+	`
+
+	documentSummaryTpl = `
+	{{- if .Settings.ShowSummary -}}
+		{{- with summary .Module -}}
+			{{ . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	documentUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{- with .Module.Usage }}
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "usage" "Usage" }}{{ end }}
+
+			{{ usage . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	documentRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
-		{{ indent 0 "#" }} Requirements
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "requirements" "Requirements" }}{{ end }}
 		{{ if not .Module.Requirements }}
 			No requirements.
+		{{ else if .Settings.SplitRequirements }}
+			{{ if or .Module.TerraformRequirements (not $.Settings.HideEmpty) }}
+				{{ indent 1 "#" }} Terraform
+				{{ if not .Module.TerraformRequirements }}
+					No Terraform requirement.
+				{{ else }}
+					The following Terraform version is needed by this module:
+					{{- range .Module.TerraformRequirements }}
+						{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+						- {{ name .Name }}{{ $version }}
+					{{- end }}
+				{{ end }}
+			{{ end }}
+			{{ if or .Module.ProviderRequirements (not $.Settings.HideEmpty) }}
+				{{ indent 1 "#" }} Providers
+				{{ if not .Module.ProviderRequirements }}
+					No provider requirement.
+				{{ else }}
+					The following provider versions are needed by this module:
+					{{- range .Module.ProviderRequirements }}
+						{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+						- {{ requirementLink .Name (name .Name) $.Module.Providers }}{{ $version }}
+					{{- end }}
+				{{ end }}
+			{{ end }}
 		{{ else }}
 			The following requirements are needed by this module:
 			{{- range .Module.Requirements }}
 				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				- {{ name .Name }}{{ $version }}
+				- {{ requirementLink .Name (name .Name) $.Module.Providers }}{{ $version }}
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -35,14 +91,60 @@ const (
 
 	documentProvidersTpl = `
 	{{- if .Settings.ShowProviders -}}
-		{{ indent 0 "#" }} Providers
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "providers" "Providers" }}{{ end }}
 		{{ if not .Module.Providers }}
 			No provider.
 		{{ else }}
 			The following providers are used by this module:
 			{{- range .Module.Providers }}
 				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				- {{ name .FullName }}{{ $version }}
+				{{ $locked := ternary (and $.Settings.ShowLockedVersions (tostring .Locked)) (printf ", locked at %s" .Locked) "" }}
+				{{ $source := ternary (and $.Settings.ShowProviderSources (tostring .Source)) (printf " (%s)" (name (tostring .Source))) "" }}
+				- {{ providerAnchor .FullName (name .FullName) }}{{ $source }}{{ $version }}{{ $locked }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	documentModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "modules" "Modules" }}{{ end }}
+		{{ if not .Module.ModuleCalls }}
+			No module.
+		{{ else }}
+			The following modules are called by this module:
+			{{- range .Module.ModuleCalls }}
+				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+				- {{ name .Name }} ({{ name .Source }}){{ $version }}
+				{{- if and $.Settings.ShowDependsOn .DependsOn }}, depends on {{ inlineCodes .DependsOn ", " }}{{ end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	documentResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "resources" "Resources" }}{{ end }}
+		{{ if not .Module.ManagedResources }}
+			No resource.
+		{{ else }}
+			The following resources are used by this module:
+			{{- range .Module.ManagedResources }}
+				- {{ resourceLink . (printf "%s.%s" (name .FullType) (name .Name)) }} ({{ name .Provider }})
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	documentDataSourcesTpl = `
+	{{- if .Settings.ShowDataSources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "data-sources" "Data Sources" }}{{ end }}
+		{{ if not .Module.DataResources }}
+			No data source.
+		{{ else }}
+			The following data sources are read by this module:
+			{{- range .Module.DataResources }}
+				- {{ resourceLink . (printf "%s.%s" (name .FullType) (name .Name)) }} ({{ name .Provider }})
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -50,14 +152,61 @@ const (
 
 	documentInputsTpl = `
 	{{- if .Settings.ShowInputs -}}
-		{{- if .Settings.ShowRequired -}}
+		{{- if .Settings.SplitRequiredOptional -}}
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "inputs" "Inputs" }}{{ end }}
+			{{ if not .Module.Inputs }}
+				No input.
+			{{ else }}
+				{{- if or .Module.RequiredInputs (not $.Settings.HideEmpty) }}
+					{{ indent 1 "#" }} Required Inputs
+					{{ if not .Module.RequiredInputs }}
+						No required input.
+					{{ else }}
+						The following input variables are required:
+						{{- range inputGroups .Module.RequiredInputs }}
+							{{- with .File }}
+								{{ printf "\n" }}
+								{{ indent 2 "#" }} {{ name . }}
+							{{- end }}
+							{{- range .Inputs }}
+								{{ template "input" . }}
+							{{- end }}
+						{{- end }}
+					{{ end }}
+				{{- end }}
+				{{- if or .Module.OptionalInputs (not $.Settings.HideEmpty) }}
+					{{ printf "\n" }}
+					{{ indent 1 "#" }} Optional Inputs
+					{{ if not .Module.OptionalInputs }}
+						No optional input.
+					{{ else }}
+						The following input variables are optional (have default values):
+						{{- range inputGroups .Module.OptionalInputs }}
+							{{- with .File }}
+								{{ printf "\n" }}
+								{{ indent 2 "#" }} {{ name . }}
+							{{- end }}
+							{{- range .Inputs }}
+								{{ template "input" . }}
+							{{- end }}
+						{{- end }}
+					{{ end }}
+				{{- end }}
+			{{ end }}
+		{{- else if .Settings.ShowRequired -}}
 			{{ indent 0 "#" }} Required Inputs
 			{{ if not .Module.RequiredInputs }}
 				No required input.
 			{{ else }}
 				The following input variables are required:
-				{{- range .Module.RequiredInputs }}
-					{{ template "input" . }}
+				{{- range inputGroups .Module.RequiredInputs }}
+					{{- with .File }}
+						{{ printf "\n" }}
+						{{ indent 1 "#" }} {{ name . }}
+					{{- end }}
+					{{- range .Inputs }}
+						{{ template "input" . }}
+					{{- end }}
 				{{- end }}
 			{{- end }}
 			{{ indent 0 "#" }} Optional Inputs
@@ -65,18 +214,30 @@ const (
 				No optional input.
 			{{ else }}
 				The following input variables are optional (have default values):
-				{{- range .Module.OptionalInputs }}
-					{{ template "input" . }}
+				{{- range inputGroups .Module.OptionalInputs }}
+					{{- with .File }}
+						{{ printf "\n" }}
+						{{ indent 1 "#" }} {{ name . }}
+					{{- end }}
+					{{- range .Inputs }}
+						{{ template "input" . }}
+					{{- end }}
 				{{- end }}
 			{{ end }}
 		{{ else -}}
-			{{ indent 0 "#" }} Inputs
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "inputs" "Inputs" }}{{ end }}
 			{{ if not .Module.Inputs }}
 				No input.
 			{{ else }}
 				The following input variables are supported:
-				{{- range .Module.Inputs }}
-					{{ template "input" . }}
+				{{- range inputGroups .Module.Inputs }}
+					{{- with .File }}
+						{{ printf "\n" }}
+						{{ indent 1 "#" }} {{ name . }}
+					{{- end }}
+					{{- range .Inputs }}
+						{{ template "input" . }}
+					{{- end }}
 				{{- end }}
 			{{ end }}
 		{{- end }}
@@ -85,49 +246,145 @@ const (
 
 	documentInputTpl = `
 	{{ printf "\n" }}
-	{{ indent 1 "#" }} {{ name .Name }}
+	{{ indent inputLevel "#" }} {{ inputAnchor .Name (name .Name) }}
+
+	{{ if sensitiveAlert .Sensitive }}
+		> [!WARNING]
+		> This input is sensitive, its value is hidden from Terraform output.
+	{{ end }}
 
-	Description: {{ tostring .Description | sanitizeDoc }}
+	{{ tostring .Description | withoutExamples | trimDescription | sanitizeDescription | description }}
+	{{- with examples (tostring .Description) }}
+		{{ printf "\n" }}
+		{{- . }}
+	{{- end }}
 
 	Type: {{ tostring .Type | type }}
 
+	{{ if showNullable }}
+		Nullable: {{ ternary .IsNullable "yes" "no" }}
+	{{ end }}
+
+	{{ if and .Sensitive showSensitivity }}
+		Sensitive: {{ sensitiveBadge .Sensitive }}
+	{{ end }}
+
 	{{ if or .HasDefault (not isRequired) }}
-		Default: {{ default "n/a" .GetValue | value }}
+		Default: {{ or (noDefault .) (default placeholder .GetValue | defaultValue) }}
+	{{- end }}
+
+	{{ with .GetActualValue }}
+		Value: {{ value . }}
+	{{- end }}
+
+	{{ if and showValidation .Validations }}
+		Validation:
+		{{ range .Validations }}
+			- {{ condition .Condition }}: {{ sanitizeDoc .ErrorMessage }}
+		{{- end }}
 	{{- end }}
 	`
 
 	documentOutputsTpl = `
 	{{- if .Settings.ShowOutputs -}}
-		{{ indent 0 "#" }} Outputs
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "outputs" "Outputs" }}{{ end }}
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
 			The following outputs are exported:
-			{{- range .Module.Outputs }}
+			{{- range outputGroups .Module.Outputs }}
+				{{- with .File }}
 
-				{{ indent 1 "#" }} {{ name .Name }}
+					{{ indent 1 "#" }} {{ name . }}
+				{{- end }}
+				{{- range .Outputs }}
 
-				Description: {{ tostring .Description | sanitizeDoc }}
+					{{ indent itemLevel "#" }} {{ name .Name }}
 
-				{{ if $.Settings.OutputValues }}
-					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
-					Value: {{ value $sensitive | sanitizeDoc }}
+					{{ tostring .Description | trimDescription | sanitizeDescription | outputDescription }}
 
-					{{ if $.Settings.ShowSensitivity -}}
-						Sensitive: {{ ternary (.Sensitive) "yes" "no" }}
-					{{- end }}
+					{{ if $.Settings.OutputValues }}
+						{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
+						Value: {{ value $sensitive | sanitizeDoc }}
+
+						{{ if $.Settings.ShowSensitivity -}}
+							Sensitive: {{ sensitiveBadge .Sensitive }}
+						{{- end }}
+					{{ end }}
 				{{ end }}
-			{{ end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	documentChecksTpl = `
+	{{- if .Settings.ShowChecks -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "checks" "Checks" }}{{ end }}
+		{{ if not .Module.Checks }}
+			No check.
+		{{ else }}
+			The following checks validate the infrastructure:
+			{{- range .Module.Checks }}
+				{{ printf "\n" }}
+				{{ indent 1 "#" }} {{ name .Name }}
+				{{ printf "\n" }}
+				{{- range .Assertions }}
+					- {{ condition .Condition }}: {{ sanitizeDoc .ErrorMessage }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	documentMovedTpl = `
+	{{- if .Settings.ShowMoved -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "moved" "Moved" }}{{ end }}
+		{{ if not .Module.Moved }}
+			No moved block.
+		{{ else }}
+			The following addresses have been moved:
+			{{- range .Module.Moved }}
+				- {{ name .From }} to {{ name .To }}
+			{{- end }}
 		{{ end }}
 	{{ end -}}
 	`
 
+	documentImportsTpl = `
+	{{- if .Settings.ShowImports -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "imports" "Imports" }}{{ end }}
+		{{ if not .Module.Imports }}
+			No import block.
+		{{ else }}
+			The following resources are imported:
+			{{- range .Module.Imports }}
+				- {{ name .To }} with id {{ condition .ID }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	documentFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	documentTpl = `
-	{{- template "header" . -}}
-	{{- template "requirements" . -}}
-	{{- template "providers" . -}}
-	{{- template "inputs" . -}}
-	{{- template "outputs" . -}}
+	{{- $toc := .Settings.ShowTOC -}}
+	{{- range sections -}}
+		{{- if and $toc (ne . "header") (ne . "footer") -}}
+			{{- template "toc" $ -}}
+			{{- $toc = false -}}
+		{{- end -}}
+		{{- include . $ -}}
+		{{- if eq . "header" -}}
+			{{- template "summary" $ -}}
+		{{- end -}}
+	{{- end -}}
 	`
 )
 
@@ -144,12 +401,30 @@ func NewDocument(settings *print.Settings) *Document {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: documentHeaderTpl,
+	}, &tmpl.Item{
+		Name: "summary",
+		Text: documentSummaryTpl,
+	}, &tmpl.Item{
+		Name: "toc",
+		Text: documentTOCTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: documentUsageTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: documentRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: documentProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: documentModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: documentResourcesTpl,
+	}, &tmpl.Item{
+		Name: "data-sources",
+		Text: documentDataSourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: documentInputsTpl,
@@ -159,10 +434,88 @@ func NewDocument(settings *print.Settings) *Document {
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: documentOutputsTpl,
+	}, &tmpl.Item{
+		Name: "checks",
+		Text: documentChecksTpl,
+	}, &tmpl.Item{
+		Name: "moved",
+		Text: documentMovedTpl,
+	}, &tmpl.Item{
+		Name: "imports",
+		Text: documentImportsTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: documentFooterTpl,
 	})
 	tt.Settings(settings)
+	describe := func(s string) string {
+		if settings.RawDescriptions && strings.Contains(s, "\n") {
+			// multi-line Markdown (e.g. lists) must start its own paragraph
+			return "Description:\n\n" + wrapLines(s, settings.MaxLineLength)
+		}
+		return wrapLines("Description: "+s, settings.MaxLineLength)
+	}
 	tt.CustomFunc(template.FuncMap{
+		"summary": summaryLine,
+		"usage":   printUsageBlock,
+		"wrap": func(s string) string {
+			return wrapLines(s, settings.MaxLineLength)
+		},
+		"inputLevel": func() int {
+			level := 1
+			if settings.GroupByFile {
+				level++
+			}
+			if settings.InputsAsSubsections {
+				level++
+			}
+			if settings.SplitRequiredOptional {
+				level++
+			}
+			return level
+		},
+		"inputAnchor": func(name string, text string) string {
+			if !settings.InputsAsSubsections {
+				return text
+			}
+			slug := anchorSlug("input", name)
+			return fmt.Sprintf("<a name=\"%s\"></a> [%s](#%s)", slug, text, slug)
+		},
+		"withoutExamples": func(s string) string {
+			if !settings.ExtractExamples {
+				return s
+			}
+			description, _ := extractExamples(s)
+			return description
+		},
+		"trimDescription": func(s string) string {
+			if !settings.TrimDescription {
+				return s
+			}
+			return firstSentence(s)
+		},
+		"examples": func(s string) string {
+			if !settings.ExtractExamples {
+				return ""
+			}
+			_, examples := extractExamples(s)
+			blocks := make([]string, 0, len(examples))
+			for _, example := range examples {
+				blocks = append(blocks, fmt.Sprintf("Example:\n\n```%s\n%s\n```", example.Language, example.Code))
+			}
+			return strings.Join(blocks, "\n\n")
+		},
+		"description": func(s string) string {
+			if !settings.CollapseDescriptions || utf8.RuneCountInString(s) <= settings.CollapseThreshold {
+				return describe(s)
+			}
+			return "Description:\n\n" + collapse(wrapLines(s, settings.MaxLineLength), settings.CollapseThreshold)
+		},
+		"outputDescription": describe,
 		"type": func(t string) string {
+			if settings.FormatComplexTypes && types.IsComplexType(t) {
+				return fmt.Sprintf("\n\n```hcl\n%s\n```\n", types.FormatComplexType(t))
+			}
 			result, extraline := printFencedCodeBlock(t, "hcl")
 			if !extraline {
 				result += "\n"
@@ -170,7 +523,7 @@ func NewDocument(settings *print.Settings) *Document {
 			return result
 		},
 		"value": func(v string) string {
-			if v == "n/a" {
+			if v == settings.Placeholder {
 				return v
 			}
 			result, extraline := printFencedCodeBlock(v, "json")
@@ -179,10 +532,39 @@ func NewDocument(settings *print.Settings) *Document {
 			}
 			return result
 		},
+		"defaultValue": func(v string) string {
+			if v == settings.Placeholder {
+				return v
+			}
+			result, extraline := printFencedCodeBlock(defaultValue(v, settings), defaultLanguage(settings))
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
 		"isRequired": func() bool {
 			return settings.ShowRequired
 		},
+		"showSensitivity": func() bool {
+			return settings.ShowSensitivity
+		},
+		"showNullable": func() bool {
+			return settings.ShowNullable
+		},
+		"showValidation": func() bool {
+			return settings.ShowValidation
+		},
+		"inlineCodes": inlineCodes,
+		"condition": func(c string) string {
+			return printInlineCode(c)
+		},
+		"sensitiveAlert": func(sensitive bool) bool {
+			return sensitive && settings.ShowSensitivity && settings.SensitiveAlerts
+		},
 	})
+	tt.CustomFunc(anchorFuncs(settings))
+	tt.CustomFunc(badgeFuncs(settings))
+	tt.CustomFunc(groupFuncs(settings))
 	return &Document{
 		template: tt,
 	}
@@ -194,5 +576,56 @@ func (d *Document) Print(module *tfconf.Module, settings *print.Settings) (strin
 	if err != nil {
 		return "", err
 	}
-	return sanitize(rendered), nil
+	if settings.HideHeadings {
+		rendered = strings.TrimLeft(rendered, "\n")
+	}
+	if settings.ShowTOC {
+		rendered = insertTOC(rendered, headingBaseLevel(settings), settings.AnchorStyle)
+	}
+	return sanitize(appendMeta(rendered, settings)), nil
+}
+
+// insertTOC replaces the TOC placeholder of 'document' with a list of links
+// to the headings of 'level' following it, slugified in anchor 'style'. Slugs
+// of all the headings, code blocks excluded, are taken into account to suffix
+// the duplicated ones the same way GitHub and GitLab do (e.g. 'providers-1').
+func insertTOC(document string, level int, style string) string {
+	const marker = "‡‡‡TOC‡‡‡"
+
+	prefix := strings.Repeat("#", level) + " "
+	slugs := make(map[string]int)
+	items := make([]string, 0)
+	passed := false
+	inCode := false
+	for _, line := range strings.Split(document, "\n") {
+		switch {
+		case strings.HasPrefix(line, "```"):
+			inCode = !inCode
+			continue
+		case inCode:
+			continue
+		case line == marker:
+			passed = true
+			continue
+		}
+		heading := strings.TrimLeft(line, "#")
+		if heading == line || !strings.HasPrefix(heading, " ") {
+			continue
+		}
+		text := strings.TrimSpace(heading)
+		slug := headingSlug(style, text)
+		if count, ok := slugs[slug]; ok {
+			slugs[slug] = count + 1
+			slug = fmt.Sprintf("%s-%d", slug, count+1)
+		} else {
+			slugs[slug] = 0
+		}
+		if passed && strings.HasPrefix(line, prefix) {
+			items = append(items, fmt.Sprintf("- [%s](#%s)", text, slug))
+		}
+	}
+	if len(items) == 0 {
+		return strings.Replace(document, marker+"\n\n", "", 1)
+	}
+	return strings.Replace(document, marker, strings.Join(items, "\n"), 1)
 }
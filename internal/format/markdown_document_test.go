@@ -1,6 +1,7 @@
 package format
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -131,11 +132,14 @@ func TestDocumentSortByType(t *testing.T) {
 func TestDocumentNoHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-NoHeader")
@@ -155,11 +159,14 @@ func TestDocumentNoHeader(t *testing.T) {
 func TestDocumentNoInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-NoInputs")
@@ -179,11 +186,14 @@ func TestDocumentNoInputs(t *testing.T) {
 func TestDocumentNoOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-NoOutputs")
@@ -203,11 +213,14 @@ func TestDocumentNoOutputs(t *testing.T) {
 func TestDocumentNoProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-NoProviders")
@@ -227,11 +240,14 @@ func TestDocumentNoProviders(t *testing.T) {
 func TestDocumentNoRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-NoRequirements")
@@ -248,14 +264,98 @@ func TestDocumentNoRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestDocumentNoResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-NoResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentNoDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-NoDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentNoModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-NoModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestDocumentOnlyHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-OnlyHeader")
@@ -275,11 +375,14 @@ func TestDocumentOnlyHeader(t *testing.T) {
 func TestDocumentOnlyInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-OnlyInputs")
@@ -296,14 +399,73 @@ func TestDocumentOnlyInputs(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestDocumentInputsAsSubsections(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		InputsAsSubsections: true,
+		ShowDataSources:     false,
+		ShowHeader:          false,
+		ShowInputs:          true,
+		ShowModules:         false,
+		ShowOutputs:         false,
+		ShowProviders:       false,
+		ShowRequirements:    false,
+		ShowResources:       false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-InputsAsSubsections")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentHideHeadings(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		HideHeadings:     true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-HideHeadings")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestDocumentOnlyOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-OnlyOutputs")
@@ -323,11 +485,14 @@ func TestDocumentOnlyOutputs(t *testing.T) {
 func TestDocumentOnlyProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-OnlyProviders")
@@ -347,11 +512,14 @@ func TestDocumentOnlyProviders(t *testing.T) {
 func TestDocumentOnlyRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "document-OnlyRequirements")
@@ -368,13 +536,20 @@ func TestDocumentOnlyRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentEscapeCharacters(t *testing.T) {
+func TestDocumentOnlyResources(t *testing.T) {
 	assert := assert.New(t)
-	settings := testutil.Settings().WithSections().With(&print.Settings{
-		EscapeCharacters: true,
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
-	expected, err := testutil.GetExpected("markdown", "document-EscapeCharacters")
+	expected, err := testutil.GetExpected("markdown", "document-OnlyResources")
 	assert.Nil(err)
 
 	options := module.NewOptions()
@@ -388,13 +563,20 @@ func TestDocumentEscapeCharacters(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentIndentationBelowAllowed(t *testing.T) {
+func TestDocumentOnlyDataSources(t *testing.T) {
 	assert := assert.New(t)
-	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 0,
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
-	expected, err := testutil.GetExpected("markdown", "document-IndentationBelowAllowed")
+	expected, err := testutil.GetExpected("markdown", "document-OnlyDataSources")
 	assert.Nil(err)
 
 	options := module.NewOptions()
@@ -408,16 +590,28 @@ func TestDocumentIndentationBelowAllowed(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentIndentationAboveAllowed(t *testing.T) {
+func TestDocumentOnlyMoved(t *testing.T) {
 	assert := assert.New(t)
-	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 10,
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
-	expected, err := testutil.GetExpected("markdown", "document-IndentationAboveAllowed")
+	expected, err := testutil.GetExpected("markdown", "document-OnlyMoved")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowMoved: true,
+	})
 	assert.Nil(err)
 
-	options := module.NewOptions()
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
@@ -428,16 +622,29 @@ func TestDocumentIndentationAboveAllowed(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentIndentationOfFour(t *testing.T) {
+func TestDocumentOnlyImports(t *testing.T) {
 	assert := assert.New(t)
-	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 4,
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowImports:      true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
-	expected, err := testutil.GetExpected("markdown", "document-IndentationOfFour")
+	expected, err := testutil.GetExpected("markdown", "document-OnlyImports")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowImports: true,
+	})
 	assert.Nil(err)
 
-	options := module.NewOptions()
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
@@ -448,19 +655,26 @@ func TestDocumentIndentationOfFour(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentOutputValues(t *testing.T) {
+func TestDocumentOnlyChecks(t *testing.T) {
 	assert := assert.New(t)
-	settings := testutil.Settings().WithSections().With(&print.Settings{
-		OutputValues:    true,
-		ShowSensitivity: true,
+	settings := testutil.Settings().With(&print.Settings{
+		ShowChecks:       true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
-	expected, err := testutil.GetExpected("markdown", "document-OutputValues")
+	expected, err := testutil.GetExpected("markdown", "document-OnlyChecks")
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		ShowChecks: true,
 	})
 	assert.Nil(err)
 
@@ -474,74 +688,43 @@ func TestDocumentOutputValues(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentHeaderFromFile(t *testing.T) {
-	tests := []struct {
-		name   string
-		golden string
-		file   string
-	}{
-		{
-			name:   "load module header from .adoc",
-			golden: "document-HeaderFromADOCFile",
-			file:   "doc.adoc",
-		},
-		{
-			name:   "load module header from .md",
-			golden: "document-HeaderFromMDFile",
-			file:   "doc.md",
-		},
-		{
-			name:   "load module header from .tf",
-			golden: "document-HeaderFromTFFile",
-			file:   "doc.tf",
-		},
-		{
-			name:   "load module header from .txt",
-			golden: "document-HeaderFromTXTFile",
-			file:   "doc.txt",
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			assert := assert.New(t)
-			settings := testutil.Settings().WithSections().Build()
-
-			expected, err := testutil.GetExpected("markdown", tt.golden)
-			assert.Nil(err)
+func TestDocumentOnlyModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
 
-			options, err := module.NewOptions().WithOverwrite(&module.Options{
-				HeaderFromFile: tt.file,
-			})
-			assert.Nil(err)
+	expected, err := testutil.GetExpected("markdown", "document-OnlyModules")
+	assert.Nil(err)
 
-			module, err := testutil.GetModule(options)
-			assert.Nil(err)
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
 
-			printer := NewDocument(settings)
-			actual, err := printer.Print(module, settings)
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
 
-			assert.Nil(err)
-			assert.Equal(expected, actual)
-		})
-	}
+	assert.Nil(err)
+	assert.Equal(expected, actual)
 }
 
-func TestDocumentOutputValuesNoSensitivity(t *testing.T) {
+func TestDocumentEscapeCharacters(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		OutputValues:    true,
-		ShowSensitivity: false,
+		EscapeMode: "markdown",
 	}).Build()
 
-	expected, err := testutil.GetExpected("markdown", "document-OutputValuesNoSensitivity")
-	assert.Nil(err)
-
-	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
-	})
+	expected, err := testutil.GetExpected("markdown", "document-EscapeCharacters")
 	assert.Nil(err)
 
+	options := module.NewOptions()
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
@@ -552,21 +735,16 @@ func TestDocumentOutputValuesNoSensitivity(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestDocumentEmpty(t *testing.T) {
+func TestDocumentMaxLineLength(t *testing.T) {
 	assert := assert.New(t)
-	settings := testutil.Settings().With(&print.Settings{
-		ShowHeader:    false,
-		ShowProviders: false,
-		ShowInputs:    false,
-		ShowOutputs:   false,
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		MaxLineLength: 40,
 	}).Build()
 
-	options, err := module.NewOptions().WithOverwrite(&module.Options{
-		HeaderFromFile: "bad.tf",
-	})
-	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
+	expected, err := testutil.GetExpected("markdown", "document-MaxLineLength")
 	assert.Nil(err)
 
+	options := module.NewOptions()
 	module, err := testutil.GetModule(options)
 	assert.Nil(err)
 
@@ -574,5 +752,959 @@ func TestDocumentEmpty(t *testing.T) {
 	actual, err := printer.Print(module, settings)
 
 	assert.Nil(err)
-	assert.Equal("", actual)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentIndentationBelowAllowed(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		HeadingBaseLevel: 0,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-IndentationBelowAllowed")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentIndentationAboveAllowed(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		HeadingBaseLevel: 10,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-IndentationAboveAllowed")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentIndentationOfFour(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		HeadingBaseLevel: 4,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-IndentationOfFour")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentOutputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-OutputValues")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSensitiveMark(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		SensitiveMark:   "🔒",
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SensitiveMark")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentBadgeStyleEmoji(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+		BadgeStyle:      "emoji",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-BadgeStyleEmoji")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentBadgeStyleShield(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+		BadgeStyle:      "shield",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-BadgeStyleShield")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentBooleanStyleTrueFalse(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+		BooleanStyle:    "true-false",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-BooleanStyleTrueFalse")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentInputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:      true,
+		OutputValues:    true,
+		ShowInputValues: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-InputValues")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+		ShowInputValues:   true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentFormatComplexTypes(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:         true,
+		ShowRequired:       true,
+		FormatComplexTypes: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-FormatComplexTypes")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentMarkMissingDefaults(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		MarkMissingDefaults: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-MarkMissingDefaults")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentHeaderFromFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		file   string
+	}{
+		{
+			name:   "load module header from .adoc",
+			golden: "document-HeaderFromADOCFile",
+			file:   "doc.adoc",
+		},
+		{
+			name:   "load module header from .md",
+			golden: "document-HeaderFromMDFile",
+			file:   "doc.md",
+		},
+		{
+			name:   "load module header from .tf",
+			golden: "document-HeaderFromTFFile",
+			file:   "doc.tf",
+		},
+		{
+			name:   "load module header from .txt",
+			golden: "document-HeaderFromTXTFile",
+			file:   "doc.txt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().WithSections().Build()
+
+			expected, err := testutil.GetExpected("markdown", tt.golden)
+			assert.Nil(err)
+
+			options, err := module.NewOptions().WithOverwrite(&module.Options{
+				HeaderFromFiles: []string{tt.file},
+			})
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(options)
+			assert.Nil(err)
+
+			printer := NewDocument(settings)
+			actual, err := printer.Print(module, settings)
+
+			assert.Nil(err)
+			assert.Equal(expected, actual)
+		})
+	}
+}
+
+func TestDocumentOutputValuesNoSensitivity(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-OutputValuesNoSensitivity")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentShowNullable(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowNullable: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-ShowNullable")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowNullable: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentShowDependsOn(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDependsOn: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-ShowDependsOn")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowDependsOn: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentShowValidation(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowValidation: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-ShowValidation")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowValidation: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentGroupByFile(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		GroupByFile: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-GroupByFile")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentGroupByFileWithRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		GroupByFile:  true,
+		ShowRequired: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-GroupByFileWithRequired")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:    false,
+		ShowProviders: false,
+		ShowInputs:    false,
+		ShowOutputs:   false,
+	}).Build()
+
+	options, err := module.NewOptions().WithOverwrite(&module.Options{
+		HeaderFromFiles: []string{"bad.tf"},
+	})
+	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal("", actual)
+}
+
+func TestDocumentWithFooter(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowFooter: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-WithFooter")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowFooter:     true,
+		FooterFromFile: "footer.md",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentWithUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowUsage: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-WithUsage")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowUsage:     true,
+		UsageFromFile: "examples/basic/main.tf",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentWithSummary(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowSummary: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-WithSummary")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentLinkResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		LinkResources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-LinkResources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		LinkResources: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentWithAnchor(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowAnchor: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-WithAnchor")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentCollapseDescriptions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		CollapseDescriptions: true,
+		CollapseThreshold:    40,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-CollapseDescriptions")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentLockedVersions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowLockedVersions: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-LockedVersions")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowLockedVersions: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentProviderSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowProviderSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-ProviderSources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowProviderSources: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentDefaultsAsHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		DefaultsAsHCL: true,
+		ShowInputs:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-DefaultsAsHCL")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentTrimDescription(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:      true,
+		ShowOutputs:     true,
+		TrimDescription: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-TrimDescription")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentPlaceholder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		Placeholder: "-",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-Placeholder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSplitRequirements(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SplitRequirements: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SplitRequirements")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSplitRequiredOptional(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowRequired:          true,
+		SplitRequiredOptional: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SplitRequiredOptional")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSplitRequiredOptionalHideEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		HideEmpty:             true,
+		ShowInputs:            true,
+		ShowRequired:          true,
+		SplitRequiredOptional: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SplitRequiredOptionalHideEmpty")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		IncludeInputs: []string{"string-2", "number-2", "map-2", "list-2"},
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentRawDescriptions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		RawDescriptions: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-RawDescriptions")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSensitiveAlerts(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SensitiveAlerts: true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SensitiveAlerts")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetTestModule("sensitive-inputs", options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSensitiveInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SensitiveInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetTestModule("sensitive-inputs", options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentShowTOC(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowRequired:      true,
+		ShowTOC:           true,
+		SplitRequirements: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-ShowTOC")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestDocumentSectionsOrder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionsOrder: []string{"outputs", "inputs"},
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "document-SectionsOrder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewDocument(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestInsertTOC(t *testing.T) {
+	document := strings.Join([]string{
+		"# Module",
+		"",
+		"‡‡‡TOC‡‡‡",
+		"",
+		"## Inputs - Optional",
+		"",
+		"## What's new? (v1.2)",
+		"",
+		"```hcl",
+		"## not a heading",
+		"```",
+		"",
+		"## Outputs",
+		"",
+		"### Outputs",
+		"",
+		"## Outputs",
+	}, "\n")
+	tests := []struct {
+		name     string
+		style    string
+		expected []string
+	}{
+		{
+			name:  "github",
+			style: "github",
+			expected: []string{
+				"- [Inputs - Optional](#inputs---optional)",
+				"- [What's new? (v1.2)](#whats-new-v12)",
+				"- [Outputs](#outputs)",
+				"- [Outputs](#outputs-2)",
+			},
+		},
+		{
+			name:  "gitlab",
+			style: "gitlab",
+			expected: []string{
+				"- [Inputs - Optional](#inputs-optional)",
+				"- [What's new? (v1.2)](#whats-new-v12)",
+				"- [Outputs](#outputs)",
+				"- [Outputs](#outputs-2)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := insertTOC(document, 2, tt.style)
+			assert.Contains(actual, strings.Join(tt.expected, "\n"))
+			assert.NotContains(actual, "‡‡‡TOC‡‡‡")
+		})
+	}
 }
@@ -1,6 +1,7 @@
 package format
 
 import (
+	"strings"
 	"text/template"
 
 	"github.com/segmentio/terraform-docs/pkg/print"
@@ -18,16 +19,66 @@ const (
 	{{ end -}}
 	`
 
+	tableSummaryTpl = `
+	{{- if .Settings.ShowSummary -}}
+		{{- with summary .Module -}}
+			{{ . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	tableUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{- with .Module.Usage }}
+			{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "usage" "Usage" }}{{ end }}
+
+			{{ usage . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	tableRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
-		{{ indent 0 "#" }} Requirements
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "requirements" "Requirements" }}{{ end }}
 		{{ if not .Module.Requirements }}
 			No requirements.
+		{{ else if .Settings.SplitRequirements }}
+			{{ indent 1 "#" }} Terraform
+			{{ if not .Module.TerraformRequirements }}
+				No Terraform requirement.
+			{{ else }}
+				| Name | Version |{{ if .Settings.ShowConstraintSource }} Source |{{ end }}
+				|------|---------|{{ if .Settings.ShowConstraintSource }}--------|{{ end }}
+				{{- range .Module.TerraformRequirements }}
+					| {{ name .Name }} | {{ tostring .Version | default placeholder }} |
+					{{- if $.Settings.ShowConstraintSource -}}
+						{{ printf " " }}{{ .Position.String | default placeholder }} |
+					{{- end -}}
+				{{- end }}
+			{{ end }}
+			{{ indent 1 "#" }} Providers
+			{{ if not .Module.ProviderRequirements }}
+				No provider requirement.
+			{{ else }}
+				| Name | Version |{{ if .Settings.ShowConstraintSource }} Source |{{ end }}
+				|------|---------|{{ if .Settings.ShowConstraintSource }}--------|{{ end }}
+				{{- range .Module.ProviderRequirements }}
+					| {{ requirementLink .Name (name .Name) $.Module.Providers }} | {{ tostring .Version | default placeholder }} |
+					{{- if $.Settings.ShowConstraintSource -}}
+						{{ printf " " }}{{ .Position.String | default placeholder }} |
+					{{- end -}}
+				{{- end }}
+			{{ end }}
 		{{ else }}
-			| Name | Version |
-			|------|---------|
+			| Name | Version |{{ if .Settings.ShowConstraintSource }} Source |{{ end }}
+			|------|---------|{{ if .Settings.ShowConstraintSource }}--------|{{ end }}
 			{{- range .Module.Requirements }}
-				| {{ name .Name }} | {{ tostring .Version | default "n/a" }} |
+				| {{ requirementLink .Name (name .Name) $.Module.Providers }} | {{ tostring .Version | default placeholder }} |
+				{{- if $.Settings.ShowConstraintSource -}}
+					{{ printf " " }}{{ .Position.String | default placeholder }} |
+				{{- end -}}
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -35,14 +86,69 @@ const (
 
 	tableProvidersTpl = `
 	{{- if .Settings.ShowProviders -}}
-		{{ indent 0 "#" }} Providers
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "providers" "Providers" }}{{ end }}
 		{{ if not .Module.Providers }}
 			No provider.
 		{{ else }}
-			| Name | Version |
-			|------|---------|
+			| Name |{{ if .Settings.ShowProviderSources }} Source |{{ end }} Version |{{ if .Settings.ShowLockedVersions }} Locked |{{ end }}
+			|------|{{ if .Settings.ShowProviderSources }}--------|{{ end }}---------|{{ if .Settings.ShowLockedVersions }}--------|{{ end }}
 			{{- range .Module.Providers }}
-				| {{ name .FullName }} | {{ tostring .Version | default "n/a" }} |
+				| {{ providerAnchor .FullName (name .FullName) }} |
+				{{- if $.Settings.ShowProviderSources -}}
+					{{ printf " " }}{{ tostring .Source | default placeholder }} |
+				{{- end -}}
+				{{ printf " " }}{{ tostring .Version | default placeholder }} |
+				{{- if $.Settings.ShowLockedVersions -}}
+					{{ printf " " }}{{ tostring .Locked | default placeholder }} |
+				{{- end -}}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "modules" "Modules" }}{{ end }}
+		{{ if not .Module.ModuleCalls }}
+			No module.
+		{{ else }}
+			| Name | Source | Version |{{ if .Settings.ShowDependsOn }} Depends On |{{ end }}
+			|------|--------|---------|{{ if .Settings.ShowDependsOn }}------------|{{ end }}
+			{{- range .Module.ModuleCalls }}
+				| {{ name .Name }} | {{ name .Source }} | {{ tostring .Version | default placeholder }} |
+				{{- if $.Settings.ShowDependsOn -}}
+					{{ printf " " }}{{ with .DependsOn }}{{ inlineCodes . "<br>" }}{{ else }}{{ placeholder }}{{ end }} |
+				{{- end -}}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "resources" "Resources" }}{{ end }}
+		{{ if not .Module.ManagedResources }}
+			No resource.
+		{{ else }}
+			| Type | Name | Provider |
+			|------|------|----------|
+			{{- range .Module.ManagedResources }}
+				| {{ resourceLink . (name .FullType) }} | {{ name .Name }} | {{ name .Provider }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableDataSourcesTpl = `
+	{{- if .Settings.ShowDataSources -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "data-sources" "Data Sources" }}{{ end }}
+		{{ if not .Module.DataResources }}
+			No data source.
+		{{ else }}
+			| Type | Name | Provider |
+			|------|------|----------|
+			{{- range .Module.DataResources }}
+				| {{ resourceLink . (name .FullType) }} | {{ name .Name }} | {{ name .Provider }} |
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -50,16 +156,34 @@ const (
 
 	tableInputsTpl = `
 	{{- if .Settings.ShowInputs -}}
-		{{ indent 0 "#" }} Inputs
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "inputs" "Inputs" }}{{ end }}
 		{{ if not .Module.Inputs }}
 			No input.
 		{{ else }}
-			| Name | Description | Type | Default |{{ if .Settings.ShowRequired }} Required |{{ end }}
-			|------|-------------|------|---------|{{ if .Settings.ShowRequired }}:--------:|{{ end }}
+			| Name | Description |{{ if showColumn "type" }} Type |{{ end }}{{ if showColumn "default" }} Default |{{ end }}{{ if showInputValues }} Value |{{ end }}{{ if .Settings.ShowValidation }} Validation |{{ end }}{{ if .Settings.ShowNullable }} Nullable |{{ end }}{{ if .Settings.ShowRequired }} Required |{{ end }}{{ if and .Settings.ShowSensitivity .Module.HasSensitiveInputs }} Sensitive |{{ end }}
+			|------|-------------|{{ if showColumn "type" }}------|{{ end }}{{ if showColumn "default" }}---------|{{ end }}{{ if showInputValues }}-------|{{ end }}{{ if .Settings.ShowValidation }}------------|{{ end }}{{ if .Settings.ShowNullable }}:--------:|{{ end }}{{ if .Settings.ShowRequired }}:--------:|{{ end }}{{ if and .Settings.ShowSensitivity .Module.HasSensitiveInputs }}:---------:|{{ end }}
 			{{- range .Module.Inputs }}
-				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} | {{ tostring .Type | type | sanitizeTbl }} | {{ value .GetValue | sanitizeTbl }} |
+				| {{ name .Name }} | {{ tostring .Description | trimDescription | wrap | sanitizeTbl }} |
+				{{- if showColumn "type" -}}
+					{{ printf " " }}{{ tostring .Type | type | sanitizeTbl }} |
+				{{- end -}}
+				{{- if showColumn "default" -}}
+					{{ printf " " }}{{ or (noDefault .) (value (wrap (defaultValue .GetValue)) | sanitizeTbl) }} |
+				{{- end -}}
+				{{- if showInputValues -}}
+					{{ printf " " }}{{ value .GetActualValue | sanitizeTbl }} |
+				{{- end -}}
+				{{- if $.Settings.ShowValidation -}}
+					{{ printf " " }}{{ range $i, $v := .Validations }}{{ if $i }}<br>{{ end }}{{ condition .Condition | sanitizeTbl }}: {{ sanitizeTbl .ErrorMessage }}{{ else }}{{ placeholder }}{{ end }} |
+				{{- end -}}
+				{{- if $.Settings.ShowNullable -}}
+					{{ printf " " }}{{ ternary .IsNullable "yes" "no" }} |
+				{{- end -}}
 				{{- if $.Settings.ShowRequired -}}
-					{{ printf " " }}{{ ternary .Required "yes" "no" }} |
+					{{ printf " " }}{{ requiredBadge .Required }} |
+				{{- end -}}
+				{{- if and $.Settings.ShowSensitivity $.Module.HasSensitiveInputs -}}
+					{{ printf " " }}{{ sensitiveBadge .Sensitive }} |
 				{{- end -}}
 			{{- end }}
 		{{ end }}
@@ -68,19 +192,19 @@ const (
 
 	tableOutputsTpl = `
 	{{- if .Settings.ShowOutputs -}}
-		{{ indent 0 "#" }} Outputs
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "outputs" "Outputs" }}{{ end }}
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
 			| Name | Description |{{ if .Settings.OutputValues }} Value |{{ if $.Settings.ShowSensitivity }} Sensitive |{{ end }}{{ end }}
 			|------|-------------|{{ if .Settings.OutputValues }}-------|{{ if $.Settings.ShowSensitivity }}:---------:|{{ end }}{{ end }}
 			{{- range .Module.Outputs }}
-				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} |
+				| {{ name .Name }} | {{ tostring .Description | trimDescription | wrap | sanitizeTbl }} |
 				{{- if $.Settings.OutputValues -}}
 					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
 					{{ printf " " }}{{ value $sensitive | sanitizeTbl }} |
 					{{- if $.Settings.ShowSensitivity -}}
-						{{ printf " " }}{{ ternary .Sensitive "yes" "no" }} |
+						{{ printf " " }}{{ sensitiveBadge .Sensitive }} |
 					{{- end -}}
 				{{- end -}}
 			{{- end }}
@@ -88,12 +212,67 @@ const (
 	{{ end -}}
 	`
 
+	tableChecksTpl = `
+	{{- if .Settings.ShowChecks -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "checks" "Checks" }}{{ end }}
+		{{ if not .Module.Checks }}
+			No check.
+		{{ else }}
+			| Name | Assertions |
+			|------|------------|
+			{{- range .Module.Checks }}
+				| {{ name .Name }} | {{ range $i, $v := .Assertions }}{{ if $i }}<br>{{ end }}{{ condition .Condition | sanitizeTbl }}: {{ sanitizeTbl .ErrorMessage }}{{ else }}{{ placeholder }}{{ end }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableMovedTpl = `
+	{{- if .Settings.ShowMoved -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "moved" "Moved" }}{{ end }}
+		{{ if not .Module.Moved }}
+			No moved block.
+		{{ else }}
+			| From | To |
+			|------|----|
+			{{- range .Module.Moved }}
+				| {{ name .From }} | {{ name .To }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableImportsTpl = `
+	{{- if .Settings.ShowImports -}}
+		{{ if not $.Settings.HideHeadings }}{{ indent 0 "#" }} {{ title "imports" "Imports" }}{{ end }}
+		{{ if not .Module.Imports }}
+			No import block.
+		{{ else }}
+			| To | ID |
+			|----|----|
+			{{- range .Module.Imports }}
+				| {{ name .To }} | {{ condition .ID | sanitizeTbl }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	tableTpl = `
-	{{- template "header" . -}}
-	{{- template "requirements" . -}}
-	{{- template "providers" . -}}
-	{{- template "inputs" . -}}
-	{{- template "outputs" . -}}
+	{{- range sections -}}
+		{{- include . $ -}}
+		{{- if eq . "header" -}}
+			{{- template "summary" $ -}}
+		{{- end -}}
+	{{- end -}}
 	`
 )
 
@@ -110,33 +289,94 @@ func NewTable(settings *print.Settings) *Table {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: tableHeaderTpl,
+	}, &tmpl.Item{
+		Name: "summary",
+		Text: tableSummaryTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: tableUsageTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: tableRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: tableProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: tableModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: tableResourcesTpl,
+	}, &tmpl.Item{
+		Name: "data-sources",
+		Text: tableDataSourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: tableInputsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: tableOutputsTpl,
+	}, &tmpl.Item{
+		Name: "checks",
+		Text: tableChecksTpl,
+	}, &tmpl.Item{
+		Name: "moved",
+		Text: tableMovedTpl,
+	}, &tmpl.Item{
+		Name: "imports",
+		Text: tableImportsTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: tableFooterTpl,
 	})
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"summary": summaryLine,
+		"usage":   printUsageBlock,
 		"type": func(t string) string {
-			inputType, _ := printFencedCodeBlock(t, "")
+			inputType, _ := printFencedCodeBlock(truncate(t, settings.TypeMaxLength), "")
 			return inputType
 		},
 		"value": func(v string) string {
-			var result = "n/a"
+			var result = settings.Placeholder
 			if v != "" {
+				if strings.Contains(v, "\n") {
+					v = escapePreformatted(v, settings)
+				}
 				result, _ = printFencedCodeBlock(v, "")
 			}
 			return result
 		},
+		"defaultValue": func(v string) string {
+			return defaultValue(v, settings)
+		},
+		"trimDescription": func(s string) string {
+			if !settings.TrimDescription {
+				return s
+			}
+			return firstSentence(s)
+		},
+		"wrap": func(s string) string {
+			return wrapLines(s, settings.WrapAt)
+		},
+		"inlineCodes": inlineCodes,
+		"condition": func(c string) string {
+			return printInlineCode(c)
+		},
+		"showColumn": func(column string) bool {
+			for _, c := range settings.HiddenColumns {
+				if c == column {
+					return false
+				}
+			}
+			return true
+		},
+		"showInputValues": func() bool {
+			return settings.OutputValues && settings.ShowInputValues
+		},
 	})
+	tt.CustomFunc(anchorFuncs(settings))
+	tt.CustomFunc(badgeFuncs(settings))
 	return &Table{
 		template: tt,
 	}
@@ -148,5 +388,8 @@ func (t *Table) Print(module *tfconf.Module, settings *print.Settings) (string,
 	if err != nil {
 		return "", err
 	}
-	return sanitize(rendered), nil
+	if settings.HideHeadings {
+		rendered = strings.TrimLeft(rendered, "\n")
+	}
+	return sanitize(appendMeta(rendered, settings)), nil
 }
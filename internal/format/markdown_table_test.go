@@ -7,6 +7,7 @@ import (
 
 	"github.com/segmentio/terraform-docs/internal/module"
 	"github.com/segmentio/terraform-docs/internal/testutil"
+	"github.com/segmentio/terraform-docs/internal/types"
 	"github.com/segmentio/terraform-docs/pkg/print"
 )
 
@@ -131,11 +132,14 @@ func TestTableSortByType(t *testing.T) {
 func TestTableNoHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-NoHeader")
@@ -155,11 +159,14 @@ func TestTableNoHeader(t *testing.T) {
 func TestTableNoInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-NoInputs")
@@ -179,11 +186,14 @@ func TestTableNoInputs(t *testing.T) {
 func TestTableNoOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-NoOutputs")
@@ -203,11 +213,14 @@ func TestTableNoOutputs(t *testing.T) {
 func TestTableNoProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-NoProviders")
@@ -227,11 +240,14 @@ func TestTableNoProviders(t *testing.T) {
 func TestTableNoRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-NoRequirements")
@@ -248,14 +264,98 @@ func TestTableNoRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestTableNoResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-NoResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableNoDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-NoDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableNoModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-NoModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestTableOnlyHeader(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-OnlyHeader")
@@ -275,11 +375,14 @@ func TestTableOnlyHeader(t *testing.T) {
 func TestTableOnlyInputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-OnlyInputs")
@@ -296,14 +399,45 @@ func TestTableOnlyInputs(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestTableHideHeadings(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		HideHeadings:     true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-HideHeadings")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestTableOnlyOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-OnlyOutputs")
@@ -323,11 +457,14 @@ func TestTableOnlyOutputs(t *testing.T) {
 func TestTableOnlyProviders(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-OnlyProviders")
@@ -347,11 +484,14 @@ func TestTableOnlyProviders(t *testing.T) {
 func TestTableOnlyRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
 		ShowHeader:       false,
 		ShowInputs:       false,
+		ShowModules:      false,
 		ShowOutputs:      false,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    false,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-OnlyRequirements")
@@ -368,10 +508,189 @@ func TestTableOnlyRequirements(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestTableOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OnlyResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableOnlyDataSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  true,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OnlyDataSources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableOnlyMoved(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OnlyMoved")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowMoved: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableOnlyImports(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowImports:      true,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OnlyImports")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowImports: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableOnlyChecks(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowChecks:       true,
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowMoved:        false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OnlyChecks")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowChecks: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableOnlyModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowDataSources:  false,
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-OnlyModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestTableEscapeCharacters(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		EscapeCharacters: true,
+		EscapeMode: "markdown",
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-EscapeCharacters")
@@ -391,7 +710,7 @@ func TestTableEscapeCharacters(t *testing.T) {
 func TestTableIndentationBelowAllowed(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 0,
+		HeadingBaseLevel: 0,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-IndentationBelowAllowed")
@@ -411,7 +730,7 @@ func TestTableIndentationBelowAllowed(t *testing.T) {
 func TestTableIndentationAboveAllowed(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 10,
+		HeadingBaseLevel: 10,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-IndentationAboveAllowed")
@@ -431,7 +750,7 @@ func TestTableIndentationAboveAllowed(t *testing.T) {
 func TestTableIndentationOfFour(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		IndentLevel: 4,
+		HeadingBaseLevel: 4,
 	}).Build()
 
 	expected, err := testutil.GetExpected("markdown", "table-IndentationOfFour")
@@ -459,8 +778,8 @@ func TestTableOutputValues(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
 	})
 	assert.Nil(err)
 
@@ -474,54 +793,262 @@ func TestTableOutputValues(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
-func TestTableHeaderFromFile(t *testing.T) {
+func TestTableOutputValuesEscapeMode(t *testing.T) {
 	tests := []struct {
-		name   string
-		golden string
-		file   string
+		name       string
+		escapeMode string
+		expected   string
 	}{
 		{
-			name:   "load module header from .adoc",
-			golden: "table-HeaderFromADOCFile",
-			file:   "doc.adoc",
-		},
-		{
-			name:   "load module header from .md",
-			golden: "table-HeaderFromMDFile",
-			file:   "doc.md",
-		},
-		{
-			name:   "load module header from .tf",
-			golden: "table-HeaderFromTFFile",
-			file:   "doc.tf",
+			name:       "markdown",
+			escapeMode: "markdown",
+			expected:   "| unquoted | It's unquoted output. | <pre>{<br>  \"\\_id\": \"a \\| b\",<br>  \"pattern\": \"\\*.tf\"<br>}</pre> |",
 		},
 		{
-			name:   "load module header from .txt",
-			golden: "table-HeaderFromTXTFile",
-			file:   "doc.txt",
+			name:       "none",
+			escapeMode: "none",
+			expected:   "| unquoted | It's unquoted output. | <pre>{<br>  \"_id\": \"a \\| b\",<br>  \"pattern\": \"*.tf\"<br>}</pre> |",
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
-			settings := testutil.Settings().WithSections().Build()
-
-			expected, err := testutil.GetExpected("markdown", tt.golden)
-			assert.Nil(err)
-
-			options, err := module.NewOptions().WithOverwrite(&module.Options{
-				HeaderFromFile: tt.file,
+			settings := testutil.Settings().With(&print.Settings{
+				EscapeMode:   tt.escapeMode,
+				OutputValues: true,
+				ShowOutputs:  true,
+			}).Build()
+
+			options, err := module.NewOptions().With(&module.Options{
+				OutputValues:      true,
+				OutputValuesPaths: []string{"output_values.json"},
 			})
 			assert.Nil(err)
 
 			module, err := testutil.GetModule(options)
 			assert.Nil(err)
+			for _, output := range module.Outputs {
+				if output.Name == "unquoted" {
+					output.Value = types.ValueOf(map[string]interface{}{"_id": "a | b", "pattern": "*.tf"})
+				}
+			}
 
 			printer := NewTable(settings)
 			actual, err := printer.Print(module, settings)
 
 			assert.Nil(err)
-			assert.Equal(expected, actual)
+			assert.Contains(actual, tt.expected)
+		})
+	}
+}
+
+func TestTableSensitiveMark(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		SensitiveMark:   "🔒",
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-SensitiveMark")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableBadgeStyleEmoji(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+		BadgeStyle:      "emoji",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-BadgeStyleEmoji")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableBadgeStyleShield(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+		BadgeStyle:      "shield",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-BadgeStyleShield")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableBooleanStyleCheckCross(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowRequired:    true,
+		ShowSensitivity: true,
+		BooleanStyle:    "check-cross",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-BooleanStyleCheckCross")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableInputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:      true,
+		OutputValues:    true,
+		ShowInputValues: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-InputValues")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+		ShowInputValues:   true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableMarkMissingDefaults(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		MarkMissingDefaults: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-MarkMissingDefaults")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableHeaderFromFile(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		file   string
+	}{
+		{
+			name:   "load module header from .adoc",
+			golden: "table-HeaderFromADOCFile",
+			file:   "doc.adoc",
+		},
+		{
+			name:   "load module header from .md",
+			golden: "table-HeaderFromMDFile",
+			file:   "doc.md",
+		},
+		{
+			name:   "load module header from .tf",
+			golden: "table-HeaderFromTFFile",
+			file:   "doc.tf",
+		},
+		{
+			name:   "load module header from .txt",
+			golden: "table-HeaderFromTXTFile",
+			file:   "doc.txt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().WithSections().Build()
+
+			expected, err := testutil.GetExpected("markdown", tt.golden)
+			assert.Nil(err)
+
+			options, err := module.NewOptions().WithOverwrite(&module.Options{
+				HeaderFromFiles: []string{tt.file},
+			})
+			assert.Nil(err)
+
+			module, err := testutil.GetModule(options)
+			assert.Nil(err)
+
+			printer := NewTable(settings)
+			actual, err := printer.Print(module, settings)
+
+			assert.Nil(err)
+			assert.Equal(expected, actual)
 		})
 	}
 }
@@ -537,8 +1064,80 @@ func TestTableOutputValuesNoSensitivity(t *testing.T) {
 	assert.Nil(err)
 
 	options, err := module.NewOptions().With(&module.Options{
-		OutputValues:     true,
-		OutputValuesPath: "output_values.json",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"output_values.json"},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableShowNullable(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowNullable: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-ShowNullable")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowNullable: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableShowDependsOn(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowDependsOn: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-ShowDependsOn")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowDependsOn: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableShowValidation(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowValidation: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-ShowValidation")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowValidation: true,
 	})
 	assert.Nil(err)
 
@@ -562,7 +1161,7 @@ func TestTableEmpty(t *testing.T) {
 	}).Build()
 
 	options, err := module.NewOptions().WithOverwrite(&module.Options{
-		HeaderFromFile: "bad.tf",
+		HeaderFromFiles: []string{"bad.tf"},
 	})
 	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
 	assert.Nil(err)
@@ -576,3 +1175,435 @@ func TestTableEmpty(t *testing.T) {
 	assert.Nil(err)
 	assert.Equal("", actual)
 }
+
+func TestTableWithFooter(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowFooter: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-WithFooter")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowFooter:     true,
+		FooterFromFile: "footer.md",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableWithUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowUsage: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-WithUsage")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowUsage:     true,
+		UsageFromFile: "examples/basic/main.tf",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableWithSummary(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowSummary: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-WithSummary")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableLinkResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		LinkResources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-LinkResources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		LinkResources: true,
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableWithAnchor(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowAnchor: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-WithAnchor")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableHeadingBaseLevel(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		HeadingBaseLevel: 4,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-HeadingBaseLevel")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableSectionTitles(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionTitles: map[string]string{
+			"inputs":  "Variables",
+			"outputs": "Exports",
+		},
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-SectionTitles")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableLockedVersions(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowLockedVersions: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-LockedVersions")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowLockedVersions: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableProviderSources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowProviderSources: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-ProviderSources")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		ShowProviderSources: true,
+	})
+	assert.Nil(err)
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableDefaultsAsHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		DefaultsAsHCL: true,
+		ShowInputs:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-DefaultsAsHCL")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableTrimDescription(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:      true,
+		ShowOutputs:     true,
+		TrimDescription: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-TrimDescription")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTablePlaceholder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		Placeholder: "-",
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-Placeholder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableHiddenColumns(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		HiddenColumns: []string{"default", "type"},
+		ShowRequired:  true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-HiddenColumns")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableSplitRequirements(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SplitRequirements: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-SplitRequirements")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableSensitiveAlerts(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SensitiveAlerts: true,
+		ShowSensitivity: true,
+	}).Build()
+
+	// alerts are only shown in document, table stays the same
+	expected, err := testutil.GetExpected("markdown", "table-SensitiveInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetTestModule("sensitive-inputs", options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableSensitiveInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-SensitiveInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetTestModule("sensitive-inputs", options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableConstraintSource(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowConstraintSource: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-ConstraintSource")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableSectionsOrder(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SectionsOrder: []string{"outputs", "inputs"},
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-SectionsOrder")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableTypeMaxLength(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:    true,
+		TypeMaxLength: 20,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-TypeMaxLength")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTableWrapAt(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:  true,
+		ShowOutputs: true,
+		WrapAt:      30,
+	}).Build()
+
+	expected, err := testutil.GetExpected("markdown", "table-WrapAt")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewTable(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
@@ -2,6 +2,7 @@ package format
 
 import (
 	"fmt"
+	"strings"
 	"text/template"
 
 	"github.com/segmentio/terraform-docs/pkg/print"
@@ -20,9 +21,23 @@ const (
 	{{ end -}}
 	`
 
+	prettyUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{- with .Module.Usage }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "usage" "Usage" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" }}
+			{{ . }}
+			{{- printf "\n" }}
+		{{ end -}}
+	{{ end -}}
+	`
+
 	prettyRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
 		{{- with .Module.Requirements }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "requirements" "Requirements" | colorize "\033[1m" }}{{ end }}
 			{{- printf "\n" -}}
 			{{- range . }}
 				{{- $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
@@ -36,6 +51,8 @@ const (
 	prettyProvidersTpl = `
 	{{- if .Settings.ShowProviders -}}
 		{{- with .Module.Providers }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "providers" "Providers" | colorize "\033[1m" }}{{ end }}
 			{{- printf "\n" -}}
 			{{- range . }}
 				{{- $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
@@ -46,12 +63,57 @@ const (
 	{{ end -}}
 	`
 
+	prettyModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{- with .Module.ModuleCalls }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "modules" "Modules" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{- $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+				{{ printf "module.%s" .Name | colorize "\033[36m" }} ({{ .Source }}){{ $version }}
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{- with .Module.ManagedResources }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "resources" "Resources" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ printf "resource.%s.%s" .FullType .Name | colorize "\033[36m" }} ({{ .Provider }})
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyDataSourcesTpl = `
+	{{- if .Settings.ShowDataSources -}}
+		{{- with .Module.DataResources }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "data-sources" "Data Sources" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ printf "%s.%s" .FullType .Name | colorize "\033[36m" }} ({{ .Provider }})
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
 	prettyInputsTpl = `
 	{{- if .Settings.ShowInputs -}}
 		{{- with .Module.Inputs }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "inputs" "Inputs" | colorize "\033[1m" }}{{ end }}
 			{{- printf "\n" -}}
 			{{- range . }}
-				{{ printf "input.%s" .Name | colorize "\033[36m" }} ({{ default "required" .GetValue }})
+				{{ printf "input.%s" .Name | colorize "\033[36m" }} [{{ tostring .Type | colorize "\033[35m" }}] ({{ .GetValue | default (colorize "\033[31m" "required") }})
 				{{ tostring .Description | trimSuffix "\n" | default "n/a" | colorize "\033[90m" }}
 			{{ end }}
 			{{- printf "\n" -}}
@@ -62,6 +124,8 @@ const (
 	prettyOutputsTpl = `
 	{{- if .Settings.ShowOutputs -}}
 		{{- with .Module.Outputs }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "outputs" "Outputs" | colorize "\033[1m" }}{{ end }}
 			{{- printf "\n" -}}
 			{{- range . }}
 				{{ printf "output.%s" .Name | colorize "\033[36m" }}
@@ -75,12 +139,65 @@ const (
 	{{ end -}}
 	`
 
+	prettyChecksTpl = `
+	{{- if .Settings.ShowChecks -}}
+		{{- with .Module.Checks }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "checks" "Checks" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ printf "check.%s" .Name | colorize "\033[36m" }}
+				{{- range .Assertions }}
+					{{ printf "  " }}{{ condition .Condition }}: {{ .ErrorMessage | colorize "\033[90m" }}
+				{{- end }}
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyMovedTpl = `
+	{{- if .Settings.ShowMoved -}}
+		{{- with .Module.Moved }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "moved" "Moved" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ .From | colorize "\033[36m" }} -> {{ .To | colorize "\033[36m" }}
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyImportsTpl = `
+	{{- if .Settings.ShowImports -}}
+		{{- with .Module.Imports }}
+			{{- printf "\n" }}
+			{{ if not $.Settings.HideHeadings }}{{ title "imports" "Imports" | colorize "\033[1m" }}{{ end }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ .To | colorize "\033[36m" }} ({{ .ID | colorize "\033[90m" }})
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer }}
+			{{- printf "\n" }}
+			{{ colorize "\033[90m" . }}
+			{{- printf "\n" }}
+		{{ end -}}
+	{{ end -}}
+	`
+
 	prettyTpl = `
-	{{- template "header" . -}}
-	{{- template "requirements" . -}}
-	{{- template "providers" . -}}
-	{{- template "inputs" . -}}
-	{{- template "outputs" . -}}
+	{{- range sections -}}
+		{{- include . $ -}}
+	{{- end -}}
 	`
 )
 
@@ -97,21 +214,48 @@ func NewPretty(settings *print.Settings) *Pretty {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: prettyHeaderTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: prettyUsageTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: prettyRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: prettyProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: prettyModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: prettyResourcesTpl,
+	}, &tmpl.Item{
+		Name: "data-sources",
+		Text: prettyDataSourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: prettyInputsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: prettyOutputsTpl,
+	}, &tmpl.Item{
+		Name: "checks",
+		Text: prettyChecksTpl,
+	}, &tmpl.Item{
+		Name: "moved",
+		Text: prettyMovedTpl,
+	}, &tmpl.Item{
+		Name: "imports",
+		Text: prettyImportsTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: prettyFooterTpl,
 	})
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"condition": func(c string) string {
+			return strings.Join(strings.Fields(c), " ")
+		},
 		"colorize": func(c string, s string) string {
 			r := "\033[0m"
 			if !settings.ShowColor {
@@ -132,5 +276,8 @@ func (p *Pretty) Print(module *tfconf.Module, settings *print.Settings) (string,
 	if err != nil {
 		return "", err
 	}
-	return rendered, nil
+	if settings.HideHeadings {
+		rendered = strings.TrimLeft(rendered, "\n")
+	}
+	return appendMeta(rendered, settings), nil
 }
@@ -0,0 +1,108 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// readme holds the sections of a module, each one rendered on its own
+// without its heading, to fill the placeholders of README template with
+// (e.g. '{{ .Inputs }}'). Sections which are hidden are left empty.
+type readme struct {
+	Header       string
+	Usage        string
+	Requirements string
+	Providers    string
+	Modules      string
+	Resources    string
+	DataSources  string
+	Inputs       string
+	Outputs      string
+	Checks       string
+	Moved        string
+	Imports      string
+	Footer       string
+}
+
+// RenderReadme renders the README template 'content', whose placeholders
+// are filled with the corresponding sections of 'module' rendered by the
+// built-in 'formatter' (e.g. "markdown table"), so the prose around them
+// is kept as written.
+func RenderReadme(formatter string, content string, module *tfconf.Module, settings *print.Settings) (string, error) {
+	tt, err := template.New("readme").Parse(content)
+	if err != nil {
+		return "", err
+	}
+
+	// render the module with only one section shown, by 'show'
+	render := func(show func(*print.Settings)) (string, error) {
+		s := *settings
+		s.ShowChecks = false
+		s.ShowDataSources = false
+		s.ShowFooter = false
+		s.ShowHeader = false
+		s.ShowImports = false
+		s.ShowInputs = false
+		s.ShowMeta = false
+		s.ShowModules = false
+		s.ShowMoved = false
+		s.ShowOutputs = false
+		s.ShowProviders = false
+		s.ShowRequirements = false
+		s.ShowResources = false
+		s.ShowUsage = false
+		s.HideHeadings = true
+		show(&s)
+
+		printer, err := Factory(formatter, &s)
+		if err != nil {
+			return "", err
+		}
+		rendered, err := printer.Print(module, &s)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(rendered, "\n"), nil
+	}
+
+	data := &readme{}
+	sections := []struct {
+		field   *string
+		visible bool
+		show    func(*print.Settings)
+	}{
+		{&data.Header, settings.ShowHeader, func(s *print.Settings) { s.ShowHeader = true }},
+		{&data.Usage, settings.ShowUsage, func(s *print.Settings) { s.ShowUsage = true }},
+		{&data.Requirements, settings.ShowRequirements, func(s *print.Settings) { s.ShowRequirements = true }},
+		{&data.Providers, settings.ShowProviders, func(s *print.Settings) { s.ShowProviders = true }},
+		{&data.Modules, settings.ShowModules, func(s *print.Settings) { s.ShowModules = true }},
+		{&data.Resources, settings.ShowResources, func(s *print.Settings) { s.ShowResources = true }},
+		{&data.DataSources, settings.ShowDataSources, func(s *print.Settings) { s.ShowDataSources = true }},
+		{&data.Inputs, settings.ShowInputs, func(s *print.Settings) { s.ShowInputs = true }},
+		{&data.Outputs, settings.ShowOutputs, func(s *print.Settings) { s.ShowOutputs = true }},
+		{&data.Checks, settings.ShowChecks, func(s *print.Settings) { s.ShowChecks = true }},
+		{&data.Moved, settings.ShowMoved, func(s *print.Settings) { s.ShowMoved = true }},
+		{&data.Imports, settings.ShowImports, func(s *print.Settings) { s.ShowImports = true }},
+		{&data.Footer, settings.ShowFooter, func(s *print.Settings) { s.ShowFooter = true }},
+	}
+	for _, section := range sections {
+		if !section.visible {
+			continue
+		}
+		rendered, err := render(section.show)
+		if err != nil {
+			return "", err
+		}
+		*section.field = rendered
+	}
+
+	var buffer bytes.Buffer
+	if err := tt.Execute(&buffer, data); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
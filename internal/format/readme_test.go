@@ -0,0 +1,75 @@
+package format
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/module"
+	"github.com/segmentio/terraform-docs/internal/testutil"
+	"github.com/segmentio/terraform-docs/pkg/print"
+)
+
+func TestRenderReadme(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	inputs, err := testutil.GetExpected("markdown", "table-HideHeadings")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	actual, err := RenderReadme("markdown table", "# Prose\n\n{{ .Inputs }}\n\nMore prose.\n", module, settings)
+
+	assert.Nil(err)
+	assert.Equal("# Prose\n\n"+strings.TrimSuffix(inputs, "\n")+"\n\nMore prose.\n", actual)
+}
+
+func TestRenderReadmeHiddenSection(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowInputs:  true,
+		ShowOutputs: false,
+	}).Build()
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	actual, err := RenderReadme("markdown table", "[{{ .Outputs }}]", module, settings)
+
+	assert.Nil(err)
+	assert.Equal("[]", actual)
+}
+
+func TestRenderReadmeInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+	}{
+		{
+			name:     "invalid syntax",
+			template: "{{ .Inputs",
+		},
+		{
+			name:     "unknown section",
+			template: "{{ .Variables }}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().WithSections().Build()
+
+			options := module.NewOptions()
+			module, err := testutil.GetModule(options)
+			assert.Nil(err)
+
+			_, err = RenderReadme("markdown table", tt.template, module, settings)
+			assert.NotNil(err)
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+// characters used to underline headings of each level, in order
+const rstHeadingChars = "=-~^\"'"
+
+// RST represents reStructuredText format, with each section
+// rendered as a list table.
+type RST struct {
+	settings *print.Settings
+}
+
+// NewRST returns new instance of RST.
+func NewRST(settings *print.Settings) *RST {
+	return &RST{
+		settings: settings,
+	}
+}
+
+// Print prints a Terraform module as reStructuredText.
+func (r *RST) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	r.settings = settings
+	buffer := bytes.NewBufferString("")
+
+	for _, section := range settings.OrderedSections() {
+		switch section {
+		case "header":
+			if settings.ShowHeader && module.Header != "" {
+				buffer.WriteString(module.Header + "\n\n")
+			}
+		case "usage":
+			if !settings.ShowUsage || module.Usage == "" {
+				continue
+			}
+			title := "Usage"
+			if t := settings.SectionTitles["usage"]; t != "" {
+				title = t
+			}
+			if !settings.HideHeadings {
+				buffer.WriteString(r.heading(0, title) + "\n\n")
+			}
+			buffer.WriteString(".. code-block:: hcl\n\n   " + indentLines(module.Usage, "   ") + "\n\n")
+		case "requirements":
+			if !settings.ShowRequirements {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Requirements))
+			for _, requirement := range module.Requirements {
+				rows = append(rows, []string{r.literal(requirement.Name), r.literal(string(requirement.Version))})
+			}
+			r.section(buffer, "requirements", "Requirements", "No requirements.", []string{"Name", "Version"}, rows)
+		case "providers":
+			if !settings.ShowProviders {
+				continue
+			}
+			columns := []string{"Name"}
+			if settings.ShowProviderSources {
+				columns = append(columns, "Source")
+			}
+			columns = append(columns, "Version")
+			if settings.ShowLockedVersions {
+				columns = append(columns, "Locked")
+			}
+			rows := make([][]string, 0, len(module.Providers))
+			for _, provider := range module.Providers {
+				row := []string{r.literal(provider.FullName())}
+				if settings.ShowProviderSources {
+					row = append(row, r.literal(string(provider.Source)))
+				}
+				row = append(row, r.literal(string(provider.Version)))
+				if settings.ShowLockedVersions {
+					row = append(row, r.literal(string(provider.Locked)))
+				}
+				rows = append(rows, row)
+			}
+			r.section(buffer, "providers", "Providers", "No provider.", columns, rows)
+		case "modules":
+			if !settings.ShowModules {
+				continue
+			}
+			rows := make([][]string, 0, len(module.ModuleCalls))
+			for _, call := range module.ModuleCalls {
+				rows = append(rows, []string{r.literal(call.Name), r.literal(call.Source), r.literal(string(call.Version))})
+			}
+			r.section(buffer, "modules", "Modules", "No module.", []string{"Name", "Source", "Version"}, rows)
+		case "resources":
+			if !settings.ShowResources {
+				continue
+			}
+			r.section(buffer, "resources", "Resources", "No resource.", []string{"Type", "Name", "Provider"}, r.resources(module.ManagedResources()))
+		case "data-sources":
+			if !settings.ShowDataSources {
+				continue
+			}
+			r.section(buffer, "data-sources", "Data Sources", "No data source.", []string{"Type", "Name", "Provider"}, r.resources(module.DataResources()))
+		case "inputs":
+			if !settings.ShowInputs {
+				continue
+			}
+			r.section(buffer, "inputs", "Inputs", "No input.", r.inputColumns(), r.inputs(module.Inputs))
+		case "outputs":
+			if !settings.ShowOutputs {
+				continue
+			}
+			columns := []string{"Name", "Description"}
+			if settings.OutputValues {
+				columns = append(columns, "Value")
+				if settings.ShowSensitivity {
+					columns = append(columns, "Sensitive")
+				}
+			}
+			rows := make([][]string, 0, len(module.Outputs))
+			for _, output := range module.Outputs {
+				row := []string{r.literal(output.Name), r.text(string(output.Description))}
+				if settings.OutputValues {
+					value := output.GetValue()
+					if output.Sensitive {
+						value = "<sensitive>"
+					}
+					row = append(row, r.literal(value))
+					if settings.ShowSensitivity {
+						row = append(row, r.yesno(output.Sensitive))
+					}
+				}
+				rows = append(rows, row)
+			}
+			r.section(buffer, "outputs", "Outputs", "No output.", columns, rows)
+		case "checks":
+			if !settings.ShowChecks {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Checks))
+			for _, check := range module.Checks {
+				assertions := make([]string, 0, len(check.Assertions))
+				for _, assertion := range check.Assertions {
+					assertions = append(assertions, printInlineCode(assertion.Condition)+": "+assertion.ErrorMessage)
+				}
+				rows = append(rows, []string{r.literal(check.Name), r.text(strings.Join(assertions, "\n\n"))})
+			}
+			r.section(buffer, "checks", "Checks", "No check.", []string{"Name", "Assertions"}, rows)
+		case "moved":
+			if !settings.ShowMoved {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Moved))
+			for _, moved := range module.Moved {
+				rows = append(rows, []string{r.literal(moved.From), r.literal(moved.To)})
+			}
+			r.section(buffer, "moved", "Moved", "No moved block.", []string{"From", "To"}, rows)
+		case "imports":
+			if !settings.ShowImports {
+				continue
+			}
+			rows := make([][]string, 0, len(module.Imports))
+			for _, i := range module.Imports {
+				rows = append(rows, []string{r.literal(i.To), r.literal(i.ID)})
+			}
+			r.section(buffer, "imports", "Imports", "No import block.", []string{"To", "ID"}, rows)
+		case "footer":
+			if settings.ShowFooter && module.Footer != "" {
+				buffer.WriteString(module.Footer + "\n\n")
+			}
+		}
+	}
+
+	return sanitize(appendMeta(strings.TrimSuffix(buffer.String(), "\n"), settings)), nil
+}
+
+func (r *RST) inputColumns() []string {
+	columns := []string{"Name", "Description"}
+	if r.showColumn("type") {
+		columns = append(columns, "Type")
+	}
+	if r.showColumn("default") {
+		columns = append(columns, "Default")
+	}
+	if r.settings.OutputValues && r.settings.ShowInputValues {
+		columns = append(columns, "Value")
+	}
+	if r.settings.ShowRequired {
+		columns = append(columns, "Required")
+	}
+	return columns
+}
+
+func (r *RST) inputs(inputs []*tfconf.Input) [][]string {
+	rows := make([][]string, 0, len(inputs))
+	for _, input := range inputs {
+		row := []string{r.literal(input.Name), r.text(string(input.Description))}
+		if r.showColumn("type") {
+			row = append(row, r.literal(truncate(string(input.Type), r.settings.TypeMaxLength)))
+		}
+		if r.showColumn("default") {
+			value := r.literal(input.GetValue())
+			if r.settings.MarkMissingDefaults {
+				if marker := input.GetDefaultMarker(r.settings.ShowRequired); marker != "" {
+					value = marker
+				}
+			}
+			row = append(row, value)
+		}
+		if r.settings.OutputValues && r.settings.ShowInputValues {
+			row = append(row, r.literal(input.GetActualValue()))
+		}
+		if r.settings.ShowRequired {
+			row = append(row, r.yesno(input.Required))
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (r *RST) resources(resources []*tfconf.Resource) [][]string {
+	rows := make([][]string, 0, len(resources))
+	for _, resource := range resources {
+		rows = append(rows, []string{r.literal(resource.FullType()), r.literal(resource.Name), r.literal(resource.Provider)})
+	}
+	return rows
+}
+
+// section writes the heading of section 'name' followed by a list table
+// of 'rows', or by 'empty' text if there's no row to show.
+func (r *RST) section(buffer *bytes.Buffer, name string, title string, empty string, columns []string, rows [][]string) {
+	if t := r.settings.SectionTitles[name]; t != "" {
+		title = t
+	}
+	if !r.settings.HideHeadings {
+		buffer.WriteString(r.heading(0, title) + "\n\n")
+	}
+	if len(rows) == 0 {
+		buffer.WriteString(empty + "\n\n")
+		return
+	}
+	buffer.WriteString(".. list-table::\n")
+	buffer.WriteString("   :header-rows: 1\n\n")
+	for _, row := range append([][]string{columns}, rows...) {
+		for i, cell := range row {
+			prefix := "     - "
+			if i == 0 {
+				prefix = "   * - "
+			}
+			buffer.WriteString(prefix + indentLines(cell, "       ") + "\n")
+		}
+	}
+	buffer.WriteString("\n")
+}
+
+// heading returns 'title' underlined with the character corresponding
+// to base heading level plus 'extra'.
+func (r *RST) heading(extra int, title string) string {
+	level := headingBaseLevel(r.settings) + extra
+	if level > len(rstHeadingChars) {
+		level = len(rstHeadingChars)
+	}
+	underline := strings.Repeat(string(rstHeadingChars[level-1]), utf8.RuneCountInString(title))
+	return title + "\n" + underline
+}
+
+// literal returns 's' as an inline literal, or as a 'code-block' if it
+// spans multiple lines.
+func (r *RST) literal(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return "n/a"
+	case strings.Contains(s, "\n"):
+		return ".. code-block:: hcl\n\n   " + indentLines(s, "   ")
+	case strings.Contains(s, "``"):
+		return rstEscape(s)
+	}
+	return "``" + s + "``"
+}
+
+// text returns 's' with its markdown code spans and code blocks converted
+// to reStructuredText and the rest escaped if enabled, or "n/a" if it's empty.
+func (r *RST) text(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "n/a"
+	}
+	lines := make([]string, 0)
+	inCode := false
+	for _, line := range strings.Split(s, "\n") {
+		if fence := strings.TrimSpace(line); strings.HasPrefix(fence, "```") {
+			if !inCode {
+				if len(lines) > 0 && lines[len(lines)-1] != "" {
+					lines = append(lines, "")
+				}
+				lines = append(lines, strings.TrimSpace(".. code-block:: "+strings.TrimPrefix(fence, "```")), "")
+			} else {
+				lines = append(lines, "")
+			}
+			inCode = !inCode
+			continue
+		}
+		switch {
+		case inCode && line != "":
+			lines = append(lines, "   "+line)
+		case inCode:
+			lines = append(lines, line)
+		default:
+			lines = append(lines, r.inline(line))
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// inline converts markdown code spans of 'line' to inline literals and
+// escapes the rest of it if enabled.
+func (r *RST) inline(line string) string {
+	segments := strings.Split(line, "`")
+	if len(segments)%2 == 0 {
+		// unbalanced backticks, there's no code span to convert
+		segments = []string{line}
+	}
+	for i, segment := range segments {
+		switch {
+		case i%2 == 1 && strings.TrimSpace(segment) != "":
+			segments[i] = r.literal(segment)
+		case i%2 == 1:
+			segments[i] = rstEscape("`" + segment + "`")
+		case r.settings.EscapeCharacters():
+			segments[i] = rstEscape(segment)
+		}
+	}
+	return strings.Join(segments, "")
+}
+
+func (r *RST) yesno(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func (r *RST) showColumn(column string) bool {
+	for _, c := range r.settings.HiddenColumns {
+		if c == column {
+			return false
+		}
+	}
+	return true
+}
+
+// rstEscape escapes characters of 's' which have special meaning in
+// reStructuredText inline markup. Underscores are only escaped at the
+// end of words, where they would be read as references (e.g. 'foo_').
+func rstEscape(s string) string {
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		switch {
+		case strings.ContainsRune("\\*`|", r):
+			b.WriteRune('\\')
+		case r == '_' && (i+1 == len(runes) || !(unicode.IsLetter(runes[i+1]) || unicode.IsDigit(runes[i+1]))):
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// indentLines prefixes all the lines of 's' but the first one with
+// 'indent', leaving empty lines empty.
+func indentLines(s string, indent string) string {
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = indent + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,39 @@
+package format
+
+import (
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+	"github.com/segmentio/terraform-docs/pkg/tmpl"
+)
+
+// Template represents a custom format, rendered with the user-provided
+// Go template in 'settings.Template'.
+type Template struct {
+	template *tmpl.Template
+}
+
+// NewTemplate returns new instance of Template, or an error if the
+// user-provided template can't be parsed.
+func NewTemplate(settings *print.Settings) (*Template, error) {
+	tt := tmpl.NewTemplate(&tmpl.Item{
+		Name: "template",
+		Text: settings.Template,
+		Raw:  true,
+	})
+	tt.Settings(settings)
+	if err := tt.Validate(); err != nil {
+		return nil, err
+	}
+	return &Template{
+		template: tt,
+	}, nil
+}
+
+// Print prints a Terraform module with the user-provided template.
+func (t *Template) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	rendered, err := t.template.Render(module)
+	if err != nil {
+		return "", err
+	}
+	return rendered, nil
+}
@@ -0,0 +1,66 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/module"
+	"github.com/segmentio/terraform-docs/internal/testutil"
+	"github.com/segmentio/terraform-docs/pkg/print"
+)
+
+const templateTpl = `# Inputs
+{{ range .Module.Inputs }}
+  - {{ .Name }} ({{ tostring .Type | default "any" }}){{ if .Required }} required{{ end }}
+{{- end }}
+
+# Outputs
+{{ range .Module.Outputs }}
+  - {{ .Name }}: {{ tostring .Description }}
+{{- end }}
+
+# Providers
+{{ range .Module.Providers }}
+  - {{ .FullName }}{{ with tostring .Version }} {{ . }}{{ end }}
+{{- end }}
+
+# Requirements
+{{ range .Module.Requirements }}
+  - {{ .Name }}{{ with tostring .Version }} {{ . }}{{ end }}
+{{- end }}
+`
+
+func TestTemplate(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		Template: templateTpl,
+	}).Build()
+
+	expected, err := testutil.GetExpected("template", "template")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer, err := NewTemplate(settings)
+	assert.Nil(err)
+
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTemplateInvalid(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		Template: "{{ range .Module.Inputs }}",
+	}).Build()
+
+	printer, err := NewTemplate(settings)
+
+	assert.Nil(printer)
+	assert.NotNil(err)
+}
@@ -0,0 +1,18 @@
+variable "password" {
+  description = "Password of the admin user."
+  type        = string
+  sensitive   = true
+}
+
+variable "token" {
+  description = "API token, generated if not set."
+  type        = string
+  default     = null
+  sensitive   = true
+}
+
+variable "username" {
+  description = "Name of the admin user."
+  type        = string
+  default     = "admin"
+}
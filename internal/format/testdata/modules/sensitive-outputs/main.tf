@@ -0,0 +1,10 @@
+output "endpoint" {
+  description = "Endpoint of the service."
+  value       = "https://example.com"
+}
+
+output "password" {
+  description = "Password of the service."
+  value       = "secret"
+  sensitive   = true
+}
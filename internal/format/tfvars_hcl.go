@@ -24,12 +24,12 @@ const (
 // TfvarsHCL represents Terraform tfvars HCL format.
 type TfvarsHCL struct {
 	template *tmpl.Template
+	padding  []int
 }
 
-var padding []int
-
 // NewTfvarsHCL returns new instance of TfvarsHCL.
 func NewTfvarsHCL(settings *print.Settings) *TfvarsHCL {
+	h := &TfvarsHCL{}
 	tt := tmpl.NewTemplate(&tmpl.Item{
 		Name: "tfvars",
 		Text: tfvarsHCLTpl,
@@ -37,7 +37,7 @@ func NewTfvarsHCL(settings *print.Settings) *TfvarsHCL {
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
 		"align": func(s string, i int) string {
-			return fmt.Sprintf("%-*s", padding[i], s)
+			return fmt.Sprintf("%-*s", h.padding[i], s)
 		},
 		"value": func(s string) string {
 			if s == "" || s == "null" {
@@ -46,14 +46,13 @@ func NewTfvarsHCL(settings *print.Settings) *TfvarsHCL {
 			return s
 		},
 	})
-	return &TfvarsHCL{
-		template: tt,
-	}
+	h.template = tt
+	return h
 }
 
 // Print prints a Terraform module as Terraform tfvars HCL document.
 func (h *TfvarsHCL) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
-	alignments(module.Inputs)
+	h.padding = alignments(module.Inputs)
 	rendered, err := h.template.Render(module)
 	if err != nil {
 		return "", err
@@ -61,8 +60,9 @@ func (h *TfvarsHCL) Print(module *tfconf.Module, settings *print.Settings) (stri
 	return strings.TrimSuffix(sanitize(rendered), "\n"), nil
 }
 
-func alignments(inputs []*tfconf.Input) {
-	padding = make([]int, len(inputs))
+// alignments returns the padding of names of 'inputs' to align their values
+func alignments(inputs []*tfconf.Input) []int {
+	padding := make([]int, len(inputs))
 	maxlen := 0
 	index := 0
 	for i, input := range inputs {
@@ -85,4 +85,5 @@ func alignments(inputs []*tfconf.Input) {
 	for i := index; i < len(inputs); i++ {
 		padding[i] = maxlen
 	}
+	return padding
 }
@@ -113,9 +113,11 @@ func TestTfvarsJsonNoInputs(t *testing.T) {
 	settings := testutil.Settings().With(&print.Settings{
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("tfvars", "json-NoInputs")
@@ -135,7 +137,7 @@ func TestTfvarsJsonNoInputs(t *testing.T) {
 func TestTfvarsJsonEscapeCharacters(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithSections().With(&print.Settings{
-		EscapeCharacters: true,
+		EscapeMode: "markdown",
 	}).Build()
 
 	expected, err := testutil.GetExpected("tfvars", "json-EscapeCharacters")
@@ -2,9 +2,12 @@ package format
 
 import (
 	"bytes"
+	"math"
+	"strings"
 
 	"github.com/BurntSushi/toml"
 
+	"github.com/segmentio/terraform-docs/internal/types"
 	"github.com/segmentio/terraform-docs/pkg/print"
 	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
@@ -21,20 +24,38 @@ func NewTOML(settings *print.Settings) *TOML {
 func (t *TOML) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
 	copy := tfconf.Module{
 		Header:       "",
+		Footer:       "",
 		Providers:    make([]*tfconf.Provider, 0),
 		Inputs:       make([]*tfconf.Input, 0),
 		Outputs:      make([]*tfconf.Output, 0),
 		Requirements: make([]*tfconf.Requirement, 0),
+		Resources:    make([]*tfconf.Resource, 0),
+		ModuleCalls:  make([]*tfconf.ModuleCall, 0),
 	}
 
 	if settings.ShowHeader {
 		copy.Header = module.Header
 	}
+	if settings.ShowFooter {
+		copy.Footer = module.Footer
+	}
+	if settings.ShowUsage {
+		copy.Usage = module.Usage
+	}
 	if settings.ShowInputs {
-		copy.Inputs = module.Inputs
+		for _, i := range module.Inputs {
+			input := *i
+			input.Default = tomlValue(i.Default)
+			input.Value = tomlValue(i.Value)
+			copy.Inputs = append(copy.Inputs, &input)
+		}
 	}
 	if settings.ShowOutputs {
-		copy.Outputs = module.Outputs
+		for _, o := range module.Outputs {
+			output := *o
+			output.Value = tomlValue(o.Value)
+			copy.Outputs = append(copy.Outputs, &output)
+		}
 	}
 	if settings.ShowProviders {
 		copy.Providers = module.Providers
@@ -42,9 +63,28 @@ func (t *TOML) Print(module *tfconf.Module, settings *print.Settings) (string, e
 	if settings.ShowRequirements {
 		copy.Requirements = module.Requirements
 	}
+	if settings.ShowResources || settings.ShowDataSources {
+		copy.Resources = filterResources(module, settings)
+	}
+	if settings.ShowModules {
+		copy.ModuleCalls = module.ModuleCalls
+	}
+	if settings.ShowChecks {
+		copy.Checks = module.Checks
+	}
+	if settings.ShowMoved {
+		copy.Moved = module.Moved
+	}
+	if settings.ShowImports {
+		copy.Imports = module.Imports
+	}
+	if settings.ShowMeta {
+		copy.Meta = newMeta(settings)
+	}
 
 	buffer := new(bytes.Buffer)
 	encoder := toml.NewEncoder(buffer)
+	encoder.Indent = strings.Repeat(" ", settings.IndentLevel)
 	err := encoder.Encode(copy)
 	if err != nil {
 		return "", err
@@ -52,3 +92,72 @@ func (t *TOML) Print(module *tfconf.Module, settings *print.Settings) (string, e
 
 	return buffer.String(), nil
 }
+
+// tomlInteger is a whole number of 'number' value, encoded as an integer
+type tomlInteger int64
+
+// HasDefault indicates a Terraform variable has a default value set.
+func (i tomlInteger) HasDefault() bool {
+	return true
+}
+
+// Length returns the length of underlying item
+func (i tomlInteger) Length() int {
+	return 0
+}
+
+// tomlValue returns 'value' with its whole numbers, which are all decoded as
+// float64, converted into integers so they're encoded as '19' not '19.0'
+func tomlValue(value types.Value) types.Value {
+	if value == nil {
+		return nil
+	}
+	return wholeNumbers(value).(types.Value)
+}
+
+func wholeNumbers(value interface{}) interface{} {
+	switch v := value.(type) {
+	case types.Number:
+		if isWholeNumber(float64(v)) {
+			return tomlInteger(v)
+		}
+	case float64:
+		if isWholeNumber(v) {
+			return int64(v)
+		}
+	case types.List:
+		return types.List(wholeNumbers([]interface{}(v)).([]interface{}))
+	case []interface{}:
+		// elements of arrays can't be of mixed types (e.g. '[1, 2.5]')
+		mixed := false
+		for _, item := range v {
+			if n, ok := item.(float64); ok && !isWholeNumber(n) {
+				mixed = true
+			}
+		}
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if _, ok := item.(float64); ok && mixed {
+				items = append(items, item)
+				continue
+			}
+			items = append(items, wholeNumbers(item))
+		}
+		return items
+	case types.Map:
+		return types.Map(wholeNumbers(map[string]interface{}(v)).(map[string]interface{}))
+	case map[string]interface{}:
+		items := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			items[key] = wholeNumbers(item)
+		}
+		return items
+	}
+	return value
+}
+
+// isWholeNumber indicates if 'n' has no fractional part and fits in the
+// range of integers a float64 holds exactly
+func isWholeNumber(n float64) bool {
+	return n == math.Trunc(n) && math.Abs(n) <= 1<<53
+}
@@ -1,9 +1,22 @@
 package format
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"text/template"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/segmentio/terraform-docs/internal/version"
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
 
 // sanitize cleans a Markdown document to soothe linters.
@@ -42,6 +55,142 @@ func printFencedCodeBlock(code string, language string) (string, bool) {
 	return fmt.Sprintf("`%s`", code), false
 }
 
+// printUsageBlock prints example 'usage' of a module in a fenced HCL code
+// block.
+func printUsageBlock(usage string) string {
+	return fmt.Sprintf("```hcl\n%s\n```", usage)
+}
+
+// printInlineCode prints 'code' wrapped inside single-tick block, with all of
+// its lines joined into one to fit in a table cell or a list item.
+func printInlineCode(code string) string {
+	return fmt.Sprintf("`%s`", strings.Join(strings.Fields(code), " "))
+}
+
+// inlineCodes prints each one of 'items' as inline code, joined by 'sep'
+// (e.g. addresses of 'depends_on' of module calls).
+func inlineCodes(items []string, sep string) string {
+	codes := make([]string, 0, len(items))
+	for _, item := range items {
+		codes = append(codes, printInlineCode(item))
+	}
+	return strings.Join(codes, sep)
+}
+
+// escapePreformatted escapes Markdown characters of a multi-line value (e.g.
+// default of inputs, value of outputs) which ends up in '<pre>' of a table
+// cell, as unlike fenced code blocks its content still gets parsed as Markdown.
+func escapePreformatted(code string, settings *print.Settings) string {
+	if !settings.EscapeCharacters() {
+		return code
+	}
+	code = strings.Replace(code, "\\", "\\\\", -1)
+	code = strings.Replace(code, "*", "\\*", -1)
+	code = strings.Replace(code, "_", "\\_", -1)
+	return code
+}
+
+// defaultValue returns 'value' of an input as is, or converted from JSON to
+// HCL syntax if settings.DefaultsAsHCL is enabled.
+func defaultValue(value string, settings *print.Settings) string {
+	if !settings.DefaultsAsHCL {
+		return value
+	}
+	return printHCLValue(value)
+}
+
+// defaultLanguage returns the language of code blocks default values of
+// inputs are rendered in.
+func defaultLanguage(settings *print.Settings) string {
+	if settings.DefaultsAsHCL {
+		return "hcl"
+	}
+	return "json"
+}
+
+var hclIdentifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// printHCLValue converts JSON encoded 'value' into HCL syntax, i.e. objects
+// as '{ key = value }' and lists as '[ value, value ]'. Non empty objects and
+// lists are printed on multiple lines and aligned like 'terraform fmt' does.
+// 'value' is returned untouched if it's not valid JSON.
+func printHCLValue(value string) string {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil {
+		return value
+	}
+	return hclValue(v, "")
+}
+
+func hclValue(value interface{}, indent string) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		return hclString(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return "[]"
+		}
+		items := make([]string, len(v))
+		for i, item := range v {
+			items[i] = indent + "  " + hclValue(item, indent+"  ")
+		}
+		return "[\n" + strings.Join(items, ",\n") + "\n" + indent + "]"
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		width := 0
+		for k := range v {
+			keys = append(keys, k)
+			if l := utf8.RuneCountInString(hclKey(k)); l > width {
+				width = l
+			}
+		}
+		sort.Strings(keys)
+		items := make([]string, len(keys))
+		for i, k := range keys {
+			key := hclKey(k)
+			padding := strings.Repeat(" ", width-utf8.RuneCountInString(key))
+			items[i] = indent + "  " + key + padding + " = " + hclValue(v[k], indent+"  ")
+		}
+		return "{\n" + strings.Join(items, "\n") + "\n" + indent + "}"
+	}
+	return fmt.Sprint(value)
+}
+
+// hclKey returns 'key' of an object bare if it's a valid identifier,
+// otherwise quoted.
+func hclKey(key string) string {
+	if hclIdentifier.MatchString(key) {
+		return key
+	}
+	return hclString(key)
+}
+
+// hclString quotes 's' and escapes template sequences ('${' and '%{') to
+// keep them literal.
+func hclString(s string) string {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(s); err != nil {
+		return strconv.Quote(s)
+	}
+	quoted := strings.TrimSuffix(buf.String(), "\n")
+	quoted = strings.Replace(quoted, "${", "$${", -1)
+	quoted = strings.Replace(quoted, "%{", "%%{", -1)
+	return quoted
+}
+
 // printFencedAsciidocCodeBlock prints codes in fences, it automatically detects if
 // the input 'code' contains '\n' it will use multi line fence, otherwise it
 // wraps the 'code' inside single-tick block.
@@ -53,3 +202,507 @@ func printFencedAsciidocCodeBlock(code string, language string) (string, bool) {
 	}
 	return fmt.Sprintf("`%s`", code), false
 }
+
+// printAsciidocUsageBlock prints example 'usage' of a module in a fenced
+// Asciidoc HCL source block.
+func printAsciidocUsageBlock(usage string) string {
+	return fmt.Sprintf("[source,hcl]\n----\n%s\n----", usage)
+}
+
+// truncate returns 'text' cut down to 'length' characters followed by an
+// ellipsis if it's longer than that. A 'length' of 0 means unlimited.
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if length <= 0 || len(runes) <= length {
+		return text
+	}
+	return strings.TrimRight(string(runes[:length]), " \n") + "..."
+}
+
+var listItemMarker = regexp.MustCompile(`^([-*+]|\d+[.)])\s+`)
+
+// firstSentence returns the first sentence of 'text', i.e. its first
+// paragraph up to the first '.', '!' or '?' followed by a capitalized word.
+// A paragraph without such boundary is returned as a whole, and if 'text'
+// starts with a list, its first item is taken instead.
+func firstSentence(text string) string {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	paragraph := make([]string, 0, len(lines))
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "```") {
+			break
+		}
+		if listItemMarker.MatchString(line) {
+			if i > 0 {
+				break
+			}
+			paragraph = append(paragraph, listItemMarker.ReplaceAllString(line, ""))
+			break
+		}
+		paragraph = append(paragraph, line)
+	}
+	sentence := []rune(strings.Join(paragraph, " "))
+
+	code := false
+	for i, r := range sentence {
+		switch {
+		case r == '`':
+			code = !code
+		case code:
+		case r == '.' || r == '!' || r == '?':
+			if i+2 < len(sentence) && sentence[i+1] == ' ' && unicode.IsUpper(sentence[i+2]) {
+				return string(sentence[:i+1])
+			}
+		}
+	}
+	return string(sentence)
+}
+
+// wrapLines wraps lines of 'text' which are longer than 'width' at word
+// boundaries. Inline code spans and URLs never get split, and fenced code
+// blocks, tables and headings are left untouched. A 'width' of 0 means
+// unlimited, i.e. no wrapping.
+func wrapLines(text string, width int) string {
+	if width <= 0 {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	result := make([]string, 0, len(lines))
+	fenced := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			fenced = !fenced
+			result = append(result, line)
+			continue
+		}
+		if fenced || utf8.RuneCountInString(line) <= width || strings.HasPrefix(trimmed, "|") || strings.HasPrefix(trimmed, "#") {
+			result = append(result, line)
+			continue
+		}
+		result = append(result, wrapLine(line, width)...)
+	}
+	return strings.Join(result, "\n")
+}
+
+// collapse wraps 'text' in a collapsible <details> block, summarized by its
+// first line, truncated at word boundaries if it's longer than 'length'.
+func collapse(text string, length int) string {
+	summary := strings.TrimSpace(strings.SplitN(text, "\n", 2)[0])
+	if length > 0 && utf8.RuneCountInString(summary) > length {
+		summary = strings.TrimSpace(wrapLine(summary, length)[0]) + "..."
+	}
+	return fmt.Sprintf("<details>\n<summary>%s</summary>\n\n%s\n\n</details>", summary, text)
+}
+
+// example is a code block extracted from an '@example' tag of a description
+type example struct {
+	Language string
+	Code     string
+}
+
+// extractExamples splits 'text' into the prose description and the blocks
+// between '@example [language]' and '@end' lines. The language defaults to
+// 'hcl' and an unterminated block runs to the end of the text.
+func extractExamples(text string) (string, []example) {
+	prose := make([]string, 0)
+	examples := make([]example, 0)
+
+	var current *example
+	var code []string
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case current == nil && (trimmed == "@example" || strings.HasPrefix(trimmed, "@example ")):
+			current = &example{Language: "hcl"}
+			if language := strings.TrimSpace(strings.TrimPrefix(trimmed, "@example")); language != "" {
+				current.Language = language
+			}
+			code = nil
+		case current != nil && trimmed == "@end":
+			current.Code = dedent(code)
+			examples = append(examples, *current)
+			current = nil
+		case current != nil:
+			code = append(code, line)
+		default:
+			prose = append(prose, line)
+		}
+	}
+	if current != nil {
+		current.Code = dedent(code)
+		examples = append(examples, *current)
+	}
+
+	description := strings.TrimSpace(strings.Join(prose, "\n"))
+	description = regexp.MustCompile(`\n{3,}`).ReplaceAllString(description, "\n\n")
+	return description, examples
+}
+
+// dedent joins 'lines' with their common leading whitespace removed and the
+// blank lines around them trimmed
+func dedent(lines []string) string {
+	prefix := ""
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if first {
+			prefix = indent
+			first = false
+		}
+		for !strings.HasPrefix(indent, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = strings.TrimRight(strings.TrimPrefix(line, prefix), " \t")
+	}
+	return strings.Trim(strings.Join(result, "\n"), "\n")
+}
+
+func wrapLine(line string, width int) []string {
+	indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+	hardbreak := strings.HasSuffix(line, "  ")
+
+	lines := make([]string, 0)
+	current := indent
+	for _, word := range splitWords(strings.TrimSpace(line)) {
+		switch {
+		case current == indent:
+			current += word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) > width:
+			lines = append(lines, current)
+			current = indent + word
+		default:
+			current += " " + word
+		}
+	}
+	if hardbreak {
+		current += "  "
+	}
+	return append(lines, current)
+}
+
+// splitWords splits 's' by space, except for the ones inside inline code spans
+func splitWords(s string) []string {
+	words := make([]string, 0)
+	word := new(strings.Builder)
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '`':
+			n := len(s[i:]) - len(strings.TrimLeft(s[i:], "`"))
+			ticks := s[i : i+n]
+			end := strings.Index(s[i+n:], ticks)
+			if end == -1 {
+				end = -n // not a code span, keep the ticks only
+			}
+			word.WriteString(s[i : i+n+end+n])
+			i += n + end + n
+		case ' ':
+			if word.Len() > 0 {
+				words = append(words, word.String())
+				word.Reset()
+			}
+			i++
+		default:
+			word.WriteByte(s[i])
+			i++
+		}
+	}
+	if word.Len() > 0 {
+		words = append(words, word.String())
+	}
+	return words
+}
+
+// filterResources returns managed and data resources of 'module' based on
+// visibility of their corresponding sections, preserving their order
+func filterResources(module *tfconf.Module, settings *print.Settings) []*tfconf.Resource {
+	resources := make([]*tfconf.Resource, 0, len(module.Resources))
+	for _, r := range module.Resources {
+		if (r.Mode == "data" && settings.ShowDataSources) || (r.Mode != "data" && settings.ShowResources) {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
+// summaryLine returns the counts of items of 'module' (e.g. "5 inputs,
+// 3 outputs, 2 providers"), leaving out the ones the module has none of.
+func summaryLine(module *tfconf.Module) string {
+	data := 0
+	for _, r := range module.Resources {
+		if r.Mode == "data" {
+			data++
+		}
+	}
+	counts := []struct {
+		count    int
+		singular string
+		plural   string
+	}{
+		{len(module.Inputs), "input", "inputs"},
+		{len(module.Outputs), "output", "outputs"},
+		{len(module.Providers), "provider", "providers"},
+		{len(module.ModuleCalls), "module", "modules"},
+		{len(module.Resources) - data, "resource", "resources"},
+		{data, "data source", "data sources"},
+	}
+	items := make([]string, 0, len(counts))
+	for _, c := range counts {
+		switch c.count {
+		case 0:
+			continue
+		case 1:
+			items = append(items, "1 "+c.singular)
+		default:
+			items = append(items, fmt.Sprintf("%d %s", c.count, c.plural))
+		}
+	}
+	return strings.Join(items, ", ")
+}
+
+// anchorFuncs returns template functions of Markdown formats which render
+// HTML anchor of providers and link requirements to their corresponding
+// provider, only if 'settings.ShowAnchor' is enabled, and link resources to
+// their documentation, only if 'settings.LinkResources' is enabled.
+func anchorFuncs(settings *print.Settings) template.FuncMap {
+	return template.FuncMap{
+		"providerAnchor": func(name string, text string) string {
+			if !settings.ShowAnchor {
+				return text
+			}
+			slug := anchorSlug("provider", name)
+			return fmt.Sprintf("<a name=\"%s\"></a> [%s](#%s)", slug, text, slug)
+		},
+		"requirementLink": func(name string, text string, providers []*tfconf.Provider) string {
+			if !settings.ShowAnchor || !settings.ShowProviders {
+				return text
+			}
+			for _, provider := range providers {
+				if provider.FullName() == name {
+					return fmt.Sprintf("[%s](#%s)", text, anchorSlug("provider", name))
+				}
+			}
+			return text
+		},
+		"resourceLink": func(resource *tfconf.Resource, text string) string {
+			if !settings.LinkResources || resource.URL == "" {
+				return text
+			}
+			return fmt.Sprintf("[%s](%s)", text, resource.URL)
+		},
+	}
+}
+
+// badgeFuncs returns template functions of Markdown formats which render
+// required and sensitive indicators in the style of 'settings.BadgeStyle',
+// either as yes/no text, as emoji or as shields.io badges. Sensitive items
+// are marked with 'settings.SensitiveMark' instead of yes in text style.
+func badgeFuncs(settings *print.Settings) template.FuncMap {
+	return template.FuncMap{
+		"requiredBadge": func(required bool) string {
+			return printBadge(settings, "required", required, "✓", "red")
+		},
+		"sensitiveBadge": func(sensitive bool) string {
+			if sensitive && settings.BadgeStyle != "emoji" && settings.BadgeStyle != "shield" &&
+				settings.BooleanStyle != "true-false" && settings.BooleanStyle != "check-cross" && settings.SensitiveMark != "" {
+				return strings.Replace(settings.SensitiveMark, "|", "\\|", -1)
+			}
+			return printBadge(settings, "sensitive", sensitive, "🔒", "orange")
+		},
+	}
+}
+
+// inputGroup is the list of inputs declared in the same file
+type inputGroup struct {
+	File   string
+	Inputs []*tfconf.Input
+}
+
+// outputGroup is the list of outputs declared in the same file
+type outputGroup struct {
+	File    string
+	Outputs []*tfconf.Output
+}
+
+// groupFuncs returns template functions of Markdown document which group
+// inputs and outputs by the file they're declared in, ordered by the name
+// of the file, only if 'settings.GroupByFile' is enabled. Otherwise all of
+// them are returned in one group without file name.
+func groupFuncs(settings *print.Settings) template.FuncMap {
+	return template.FuncMap{
+		"inputGroups": func(inputs []*tfconf.Input) []*inputGroup {
+			if !settings.GroupByFile {
+				return []*inputGroup{{Inputs: inputs}}
+			}
+			groups := make([]*inputGroup, 0)
+			indices := make(map[string]int)
+			for _, i := range inputs {
+				file := filepath.Base(i.Position.Filename)
+				if _, ok := indices[file]; !ok {
+					indices[file] = len(groups)
+					groups = append(groups, &inputGroup{File: file})
+				}
+				groups[indices[file]].Inputs = append(groups[indices[file]].Inputs, i)
+			}
+			sort.SliceStable(groups, func(i, j int) bool { return groups[i].File < groups[j].File })
+			return groups
+		},
+		"outputGroups": func(outputs []*tfconf.Output) []*outputGroup {
+			if !settings.GroupByFile {
+				return []*outputGroup{{Outputs: outputs}}
+			}
+			groups := make([]*outputGroup, 0)
+			indices := make(map[string]int)
+			for _, o := range outputs {
+				file := filepath.Base(o.Position.Filename)
+				if _, ok := indices[file]; !ok {
+					indices[file] = len(groups)
+					groups = append(groups, &outputGroup{File: file})
+				}
+				groups[indices[file]].Outputs = append(groups[indices[file]].Outputs, o)
+			}
+			sort.SliceStable(groups, func(i, j int) bool { return groups[i].File < groups[j].File })
+			return groups
+		},
+		"itemLevel": func() int {
+			if settings.GroupByFile {
+				return 2
+			}
+			return 1
+		},
+	}
+}
+
+// booleanValues are the pairs of texts 'true' and 'false' are rendered as
+// with 'text' badge style, keyed by their boolean style
+var booleanValues = map[string][2]string{
+	"yes-no":      {"yes", "no"},
+	"true-false":  {"true", "false"},
+	"check-cross": {"✓", "✗"},
+}
+
+// printBadge prints the indicator of 'label' being 'enabled' in badge style
+// of 'settings', with 'emoji' or 'color' of the shields.io badge if it's
+// enabled. The 'text' style is rendered in the boolean style of 'settings'.
+func printBadge(settings *print.Settings, label string, enabled bool, emoji string, color string) string {
+	value := "no"
+	if enabled {
+		value = "yes"
+	}
+	switch settings.BadgeStyle {
+	case "emoji":
+		if enabled {
+			return emoji
+		}
+		return "-"
+	case "shield":
+		if !enabled {
+			color = "lightgrey"
+		}
+		return fmt.Sprintf("![%s](https://img.shields.io/badge/%s-%s-%s)", value, label, value, color)
+	}
+	if values, ok := booleanValues[settings.BooleanStyle]; ok {
+		if enabled {
+			return values[0]
+		}
+		return values[1]
+	}
+	return value
+}
+
+// anchorSlug returns a stable slug of 'name' prefixed with its 'kind', which
+// only contains lowercase letters, digits and underscore (e.g. 'provider_aws_ident')
+func anchorSlug(kind string, name string) string {
+	slug := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_':
+			return r
+		case r >= 'A' && r <= 'Z':
+			return r + 'a' - 'A'
+		}
+		return '_'
+	}, name)
+	return kind + "_" + slug
+}
+
+// headingBaseLevel returns the level of section headings, which is taken
+// from 'settings.HeadingBaseLevel' and falls back to 2 if it's out of range
+func headingBaseLevel(settings *print.Settings) int {
+	base := settings.HeadingBaseLevel
+	if base < 1 || base > 5 {
+		base = 2
+	}
+	return base
+}
+
+// headingSlug returns the anchor slug of a Markdown heading of 'text' in
+// 'style', which is either 'github' (default) or 'gitlab'
+func headingSlug(style string, text string) string {
+	if style == "gitlab" {
+		return gitlabSlug(text)
+	}
+	return githubSlug(text)
+}
+
+// gitlabSlug returns the anchor slug GitLab generates for a Markdown heading
+// of 'text', which is the same as GitHub but with consecutive hyphens squeezed
+// into one (e.g. 'Inputs - Optional' becomes 'inputs-optional')
+func gitlabSlug(text string) string {
+	return regexp.MustCompile(`-{2,}`).ReplaceAllString(githubSlug(text), "-")
+}
+
+// githubSlug returns the anchor slug GitHub generates for a Markdown heading
+// of 'text': lowercased, stripped from HTML tags and punctuation, and with
+// spaces replaced by hyphens (e.g. 'Data Sources' becomes 'data-sources')
+func githubSlug(text string) string {
+	text = regexp.MustCompile(`<[^>]*>`).ReplaceAllString(text, "")
+	var slug strings.Builder
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), unicode.IsMark(r), r == '-', r == '_':
+			slug.WriteRune(r)
+		case r == ' ':
+			slug.WriteRune('-')
+		}
+	}
+	return slug.String()
+}
+
+// now returns the current time, which documents are generated at
+var now = time.Now
+
+// newMeta returns the version of terraform-docs and, unless it's disabled
+// with 'settings.MetaTimestamp', the current time in UTC.
+func newMeta(settings *print.Settings) *tfconf.Meta {
+	meta := &tfconf.Meta{
+		Version: version.Short(),
+	}
+	if settings.MetaTimestamp {
+		meta.Timestamp = now().UTC().Format(time.RFC3339)
+	}
+	return meta
+}
+
+// appendMeta appends the line of "Meta" information to the end of the
+// 'document', only if 'settings.ShowMeta' is enabled.
+func appendMeta(document string, settings *print.Settings) string {
+	if !settings.ShowMeta {
+		return document
+	}
+	meta := newMeta(settings)
+	line := "Generated by terraform-docs " + meta.Version
+	if meta.Timestamp != "" {
+		line += " on " + meta.Timestamp
+	}
+	if strings.TrimSpace(document) == "" {
+		return line + "\n"
+	}
+	return strings.TrimRight(document, "\n") + "\n\n" + line + "\n"
+}
@@ -2,8 +2,13 @@ package format
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/version"
+	"github.com/segmentio/terraform-docs/pkg/print"
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
 
 func TestSanitizeMarkdown(t *testing.T) {
@@ -119,3 +124,541 @@ func TestFenceCodeBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintHCLValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "null",
+			value:    "null",
+			expected: "null",
+		},
+		{
+			name:     "primitive",
+			value:    "42",
+			expected: "42",
+		},
+		{
+			name:     "string",
+			value:    "\"${var.foo}-%{bar}\"",
+			expected: "\"$${var.foo}-%%{bar}\"",
+		},
+		{
+			name:     "empty object",
+			value:    "{}",
+			expected: "{}",
+		},
+		{
+			name:     "empty list",
+			value:    "[]",
+			expected: "[]",
+		},
+		{
+			name:     "list",
+			value:    "[\n  \"foo\",\n  true\n]",
+			expected: "[\n  \"foo\",\n  true\n]",
+		},
+		{
+			name:     "object",
+			value:    "{\n  \"name\": \"hello\",\n  \"key with space\": 1.5,\n  \"tags\": {\n    \"env\": null\n  }\n}",
+			expected: "{\n  \"key with space\" = 1.5\n  name             = \"hello\"\n  tags             = {\n    env = null\n  }\n}",
+		},
+		{
+			name:     "invalid",
+			value:    "not json",
+			expected: "not json",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printHCLValue(tt.value)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestWrapLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected string
+	}{
+		{
+			name:     "unlimited",
+			text:     "foo bar baz",
+			width:    0,
+			expected: "foo bar baz",
+		},
+		{
+			name:     "short line",
+			text:     "foo bar baz",
+			width:    20,
+			expected: "foo bar baz",
+		},
+		{
+			name:     "long line",
+			text:     "foo bar baz qux",
+			width:    8,
+			expected: "foo bar\nbaz qux",
+		},
+		{
+			name:     "multi line",
+			text:     "foo bar baz\nqux quux",
+			width:    8,
+			expected: "foo bar\nbaz\nqux quux",
+		},
+		{
+			name:     "inline code span",
+			text:     "foo `bar baz` qux",
+			width:    8,
+			expected: "foo\n`bar baz`\nqux",
+		},
+		{
+			name:     "inline code span with double ticks",
+			text:     "foo ``bar ` baz`` qux",
+			width:    8,
+			expected: "foo\n``bar ` baz``\nqux",
+		},
+		{
+			name:     "url",
+			text:     "see https://domain.com/foo/bar for details",
+			width:    8,
+			expected: "see\nhttps://domain.com/foo/bar\nfor\ndetails",
+		},
+		{
+			name:     "hard line break",
+			text:     "foo bar baz  \nqux",
+			width:    8,
+			expected: "foo bar\nbaz  \nqux",
+		},
+		{
+			name:     "indented line",
+			text:     "  foo bar baz",
+			width:    8,
+			expected: "  foo\n  bar\n  baz",
+		},
+		{
+			name:     "fenced code block",
+			text:     "foo bar baz\n```\nfoo bar baz\n```",
+			width:    8,
+			expected: "foo bar\nbaz\n```\nfoo bar baz\n```",
+		},
+		{
+			name:     "table",
+			text:     "| foo | bar | baz |",
+			width:    8,
+			expected: "| foo | bar | baz |",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := wrapLines(tt.text, tt.width)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestAnchorSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		value    string
+		expected string
+	}{
+		{
+			name:     "simple name",
+			kind:     "provider",
+			value:    "aws",
+			expected: "provider_aws",
+		},
+		{
+			name:     "name with alias",
+			kind:     "provider",
+			value:    "aws.ident",
+			expected: "provider_aws_ident",
+		},
+		{
+			name:     "name with uppercase and dash",
+			kind:     "provider",
+			value:    "Foo-Bar_baz",
+			expected: "provider_foo_bar_baz",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, anchorSlug(tt.kind, tt.value))
+		})
+	}
+}
+
+func TestCollapse(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		length   int
+		expected string
+	}{
+		{
+			name:     "short first line",
+			text:     "foo bar\nbaz",
+			length:   10,
+			expected: "<details>\n<summary>foo bar</summary>\n\nfoo bar\nbaz\n\n</details>",
+		},
+		{
+			name:     "long first line",
+			text:     "foo bar baz qux",
+			length:   8,
+			expected: "<details>\n<summary>foo bar...</summary>\n\nfoo bar baz qux\n\n</details>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, collapse(tt.text, tt.length))
+		})
+	}
+}
+
+func TestExtractExamples(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		description string
+		examples    []example
+	}{
+		{
+			name:        "no example",
+			text:        "foo bar",
+			description: "foo bar",
+			examples:    []example{},
+		},
+		{
+			name:        "default language",
+			text:        "foo\n\n@example\n  bar = \"baz\"\n@end\n\nqux",
+			description: "foo\n\nqux",
+			examples:    []example{{Language: "hcl", Code: "bar = \"baz\""}},
+		},
+		{
+			name:        "language and unterminated",
+			text:        "foo\n@example json\n{\n  \"bar\": 1\n}\n",
+			description: "foo",
+			examples:    []example{{Language: "json", Code: "{\n  \"bar\": 1\n}"}},
+		},
+		{
+			name:        "multiple examples",
+			text:        "@example\nfoo\n@end\n@example\nbar\n@end",
+			description: "",
+			examples:    []example{{Language: "hcl", Code: "foo"}, {Language: "hcl", Code: "bar"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			description, examples := extractExamples(tt.text)
+			assert.Equal(tt.description, description)
+			assert.Equal(tt.examples, examples)
+		})
+	}
+}
+
+func TestGithubSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "lowercase words",
+			text:     "Data Sources",
+			expected: "data-sources",
+		},
+		{
+			name:     "strip punctuation",
+			text:     "What's new? (v1.2)",
+			expected: "whats-new-v12",
+		},
+		{
+			name:     "keep hyphen and underscore",
+			text:     "input-with_underscores",
+			expected: "input-with_underscores",
+		},
+		{
+			name:     "strip escapes and html tags",
+			text:     "<a name=\"foo\"></a> foo\\_bar",
+			expected: "foo_bar",
+		},
+		{
+			name:     "keep unicode letters",
+			text:     "Übersicht Données",
+			expected: "übersicht-données",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := githubSlug(tt.text)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestGitlabSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "lowercase words",
+			text:     "Data Sources",
+			expected: "data-sources",
+		},
+		{
+			name:     "strip punctuation",
+			text:     "What's new? (v1.2)",
+			expected: "whats-new-v12",
+		},
+		{
+			name:     "squeeze consecutive hyphens",
+			text:     "Inputs - Optional",
+			expected: "inputs-optional",
+		},
+		{
+			name:     "squeeze hyphens left by punctuation",
+			text:     "foo & bar -- baz",
+			expected: "foo-bar-baz",
+		},
+		{
+			name:     "strip escapes and html tags",
+			text:     "<a name=\"foo\"></a> foo\\_bar",
+			expected: "foo_bar",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := gitlabSlug(tt.text)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		length   int
+		expected string
+	}{
+		{
+			name:     "unlimited",
+			text:     "object({ name = string })",
+			length:   0,
+			expected: "object({ name = string })",
+		},
+		{
+			name:     "shorter than length",
+			text:     "list(string)",
+			length:   20,
+			expected: "list(string)",
+		},
+		{
+			name:     "exactly length",
+			text:     "list(string)",
+			length:   12,
+			expected: "list(string)",
+		},
+		{
+			name:     "longer than length",
+			text:     "object({ name = string })",
+			length:   10,
+			expected: "object({ n...",
+		},
+		{
+			name:     "trailing whitespace trimmed",
+			text:     "object({\n  name = string\n})",
+			length:   9,
+			expected: "object({...",
+		},
+		{
+			name:     "multibyte characters",
+			text:     "ünïcödé",
+			length:   3,
+			expected: "ünï...",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, truncate(tt.text, tt.length))
+		})
+	}
+}
+
+func TestSummaryLine(t *testing.T) {
+	tests := []struct {
+		name     string
+		module   *tfconf.Module
+		expected string
+	}{
+		{
+			name:     "empty module",
+			module:   &tfconf.Module{},
+			expected: "",
+		},
+		{
+			name: "singular and plural",
+			module: &tfconf.Module{
+				Inputs:    []*tfconf.Input{{Name: "a"}, {Name: "b"}},
+				Providers: []*tfconf.Provider{{Name: "aws"}},
+				Resources: []*tfconf.Resource{{Type: "instance", Mode: "managed"}, {Type: "ami", Mode: "data"}},
+			},
+			expected: "2 inputs, 1 provider, 1 resource, 1 data source",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, summaryLine(tt.module))
+		})
+	}
+}
+
+func TestFirstSentence(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "empty",
+			text:     "",
+			expected: "",
+		},
+		{
+			name:     "one sentence",
+			text:     "It's number one.",
+			expected: "It's number one.",
+		},
+		{
+			name:     "multiple sentences",
+			text:     "It's number one. It's the first one! Really?",
+			expected: "It's number one.",
+		},
+		{
+			name:     "no period",
+			text:     "It's number one",
+			expected: "It's number one",
+		},
+		{
+			name:     "multiple lines",
+			text:     "This is a long sentence\nwrapped on two lines. Second one.",
+			expected: "This is a long sentence wrapped on two lines.",
+		},
+		{
+			name:     "multiple paragraphs",
+			text:     "First paragraph without period\n\nSecond paragraph.",
+			expected: "First paragraph without period",
+		},
+		{
+			name:     "abbreviations and versions",
+			text:     "Version of the engine (e.g. v1.2.3). Defaults to latest.",
+			expected: "Version of the engine (e.g. v1.2.3).",
+		},
+		{
+			name:     "inline code",
+			text:     "Set `foo. Bar` to enable it. Otherwise disabled.",
+			expected: "Set `foo. Bar` to enable it.",
+		},
+		{
+			name:     "followed by list",
+			text:     "Allowed values\n- foo\n- bar",
+			expected: "Allowed values",
+		},
+		{
+			name:     "starting with list",
+			text:     "- First item. More.\n- Second item",
+			expected: "First item.",
+		},
+		{
+			name:     "starting with ordered list",
+			text:     "1. First item\n2. Second item",
+			expected: "First item",
+		},
+		{
+			name:     "followed by code block",
+			text:     "Example:\n```hcl\nfoo = true\n```",
+			expected: "Example:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, firstSentence(tt.text))
+		})
+	}
+}
+
+func TestAppendMeta(t *testing.T) {
+	now = func() time.Time {
+		return time.Date(2021, 2, 3, 4, 5, 6, 0, time.FixedZone("CET", 3600))
+	}
+	defer func() {
+		now = time.Now
+	}()
+
+	tests := []struct {
+		name      string
+		document  string
+		show      bool
+		timestamp bool
+		expected  string
+	}{
+		{
+			name:      "meta not shown",
+			document:  "# Title\n",
+			show:      false,
+			timestamp: true,
+			expected:  "# Title\n",
+		},
+		{
+			name:      "with timestamp",
+			document:  "# Title\n",
+			show:      true,
+			timestamp: true,
+			expected:  "# Title\n\nGenerated by terraform-docs " + version.Short() + " on 2021-02-03T03:05:06Z\n",
+		},
+		{
+			name:      "without timestamp",
+			document:  "# Title\n\n",
+			show:      true,
+			timestamp: false,
+			expected:  "# Title\n\nGenerated by terraform-docs " + version.Short() + "\n",
+		},
+		{
+			name:      "empty document",
+			document:  "",
+			show:      true,
+			timestamp: false,
+			expected:  "Generated by terraform-docs " + version.Short() + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := &print.Settings{
+				ShowMeta:      tt.show,
+				MetaTimestamp: tt.timestamp,
+			}
+			assert.Equal(tt.expected, appendMeta(tt.document, settings))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package format
 
 import (
+	"bytes"
 	"encoding/xml"
 	"strings"
 
@@ -8,6 +9,9 @@ import (
 	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
 
+// xsiNamespace is the namespace of 'xsi:nil' attribute of empty elements
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
 // XML represents XML format.
 type XML struct{}
 
@@ -20,15 +24,24 @@ func NewXML(settings *print.Settings) *XML {
 func (x *XML) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
 	copy := &tfconf.Module{
 		Header:       "",
+		Footer:       "",
 		Inputs:       make([]*tfconf.Input, 0),
 		Outputs:      make([]*tfconf.Output, 0),
 		Providers:    make([]*tfconf.Provider, 0),
 		Requirements: make([]*tfconf.Requirement, 0),
+		Resources:    make([]*tfconf.Resource, 0),
+		ModuleCalls:  make([]*tfconf.ModuleCall, 0),
 	}
 
 	if settings.ShowHeader {
 		copy.Header = module.Header
 	}
+	if settings.ShowFooter {
+		copy.Footer = module.Footer
+	}
+	if settings.ShowUsage {
+		copy.Usage = module.Usage
+	}
 	if settings.ShowInputs {
 		copy.Inputs = module.Inputs
 	}
@@ -41,11 +54,39 @@ func (x *XML) Print(module *tfconf.Module, settings *print.Settings) (string, er
 	if settings.ShowRequirements {
 		copy.Requirements = module.Requirements
 	}
+	if settings.ShowResources || settings.ShowDataSources {
+		copy.Resources = filterResources(module, settings)
+	}
+	if settings.ShowModules {
+		copy.ModuleCalls = module.ModuleCalls
+	}
+	if settings.ShowChecks {
+		copy.Checks = module.Checks
+	}
+	if settings.ShowMoved {
+		copy.Moved = module.Moved
+	}
+	if settings.ShowImports {
+		copy.Imports = module.Imports
+	}
+	if settings.ShowMeta {
+		copy.Meta = newMeta(settings)
+	}
 
-	out, err := xml.MarshalIndent(copy, "", "  ")
-	if err != nil {
+	buffer := new(bytes.Buffer)
+
+	encoder := xml.NewEncoder(buffer)
+	encoder.Indent("", strings.Repeat(" ", settings.IndentLevel))
+
+	start := xml.StartElement{
+		Name: xml.Name{Local: "module"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		},
+	}
+	if err := encoder.EncodeElement(copy, start); err != nil {
 		return "", err
 	}
 
-	return strings.TrimSuffix(string(out), "\n"), nil
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
 }
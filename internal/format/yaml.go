@@ -22,15 +22,24 @@ func NewYAML(settings *print.Settings) *YAML {
 func (y *YAML) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
 	copy := &tfconf.Module{
 		Header:       "",
+		Footer:       "",
 		Inputs:       make([]*tfconf.Input, 0),
 		Outputs:      make([]*tfconf.Output, 0),
 		Providers:    make([]*tfconf.Provider, 0),
 		Requirements: make([]*tfconf.Requirement, 0),
+		Resources:    make([]*tfconf.Resource, 0),
+		ModuleCalls:  make([]*tfconf.ModuleCall, 0),
 	}
 
 	if settings.ShowHeader {
 		copy.Header = module.Header
 	}
+	if settings.ShowFooter {
+		copy.Footer = module.Footer
+	}
+	if settings.ShowUsage {
+		copy.Usage = module.Usage
+	}
 	if settings.ShowInputs {
 		copy.Inputs = module.Inputs
 	}
@@ -43,11 +52,29 @@ func (y *YAML) Print(module *tfconf.Module, settings *print.Settings) (string, e
 	if settings.ShowRequirements {
 		copy.Requirements = module.Requirements
 	}
+	if settings.ShowResources || settings.ShowDataSources {
+		copy.Resources = filterResources(module, settings)
+	}
+	if settings.ShowModules {
+		copy.ModuleCalls = module.ModuleCalls
+	}
+	if settings.ShowChecks {
+		copy.Checks = module.Checks
+	}
+	if settings.ShowMoved {
+		copy.Moved = module.Moved
+	}
+	if settings.ShowImports {
+		copy.Imports = module.Imports
+	}
+	if settings.ShowMeta {
+		copy.Meta = newMeta(settings)
+	}
 
 	buffer := new(bytes.Buffer)
 
 	encoder := yaml.NewEncoder(buffer)
-	encoder.SetIndent(2)
+	encoder.SetIndent(settings.IndentLevel)
 
 	err := encoder.Encode(copy)
 	if err != nil {
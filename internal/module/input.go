@@ -28,7 +28,10 @@ type inputsSortedByPosition []*tfconf.Input
 func (a inputsSortedByPosition) Len() int      { return len(a) }
 func (a inputsSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 func (a inputsSortedByPosition) Less(i, j int) bool {
-	return a[i].Position.Filename < a[j].Position.Filename || a[i].Position.Line < a[j].Position.Line
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
 }
 
 type inputsSortedByType []*tfconf.Input
@@ -41,3 +44,33 @@ func (a inputsSortedByType) Less(i, j int) bool {
 	}
 	return a[i].Type < a[j].Type
 }
+
+// inputsSortedByKeys sorts inputs by 'keys' criteria [name, required, type]
+// compared in turn, and by name if all of them are equal
+type inputsSortedByKeys struct {
+	inputs []*tfconf.Input
+	keys   []string
+}
+
+func (a inputsSortedByKeys) Len() int      { return len(a.inputs) }
+func (a inputsSortedByKeys) Swap(i, j int) { a.inputs[i], a.inputs[j] = a.inputs[j], a.inputs[i] }
+func (a inputsSortedByKeys) Less(i, j int) bool {
+	x, y := a.inputs[i], a.inputs[j]
+	for _, key := range a.keys {
+		switch key {
+		case "required":
+			if x.HasDefault() != y.HasDefault() {
+				return !x.HasDefault()
+			}
+		case "type":
+			if x.Type != y.Type {
+				return x.Type < y.Type
+			}
+		case "name":
+			if x.Name != y.Name {
+				return x.Name < y.Name
+			}
+		}
+	}
+	return x.Name < y.Name
+}
@@ -58,6 +58,71 @@ func TestInputsSortedByPosition(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestInputsSortedByPositionMultipleFiles(t *testing.T) {
+	assert := assert.New(t)
+	inputs := []*tfconf.Input{
+		{Name: "c", Position: tfconf.Position{Filename: "foo/variables.tf", Line: 5}},
+		{Name: "a", Position: tfconf.Position{Filename: "foo/network.tf", Line: 30}},
+		{Name: "d", Position: tfconf.Position{Filename: "foo/variables.tf", Line: 1}},
+		{Name: "b", Position: tfconf.Position{Filename: "foo/network.tf", Line: 12}},
+	}
+
+	sort.Sort(inputsSortedByPosition(inputs))
+
+	expected := []string{"b", "a", "d", "c"}
+	actual := make([]string, len(inputs))
+
+	for k, i := range inputs {
+		actual[k] = i.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func TestInputsSortedByKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []string
+		expected []string
+	}{
+		{
+			name:     "sort inputs by name",
+			keys:     []string{"name"},
+			expected: []string{"a", "b", "c", "d", "e", "f"},
+		},
+		{
+			name:     "sort inputs by required",
+			keys:     []string{"required"},
+			expected: []string{"b", "d", "a", "c", "e", "f"},
+		},
+		{
+			name:     "sort inputs by required and type",
+			keys:     []string{"required", "type"},
+			expected: []string{"b", "d", "e", "c", "a", "f"},
+		},
+		{
+			name:     "sort inputs by type and required",
+			keys:     []string{"type", "required"},
+			expected: []string{"e", "c", "b", "d", "a", "f"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			inputs := sampleInputs()
+
+			sort.Sort(inputsSortedByKeys{inputs: inputs, keys: tt.keys})
+
+			actual := make([]string, len(inputs))
+			for k, i := range inputs {
+				actual[k] = i.Name
+			}
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func sampleInputs() []*tfconf.Input {
 	return []*tfconf.Input{
 		{
@@ -0,0 +1,80 @@
+package module
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclparse"
+
+	"github.com/segmentio/terraform-docs/internal/tfconfig"
+)
+
+// lockfileName is the name of dependency lock file of a module
+const lockfileName = ".terraform.lock.hcl"
+
+// lockedProvider is a 'provider' block of the lock file, e.g.
+//
+//	provider "registry.terraform.io/hashicorp/aws" {
+//	  version     = "3.10.0"
+//	  constraints = ">= 2.15.0"
+//	  hashes      = [...]
+//	}
+type lockedProvider struct {
+	Source  string   `hcl:"source,label"`
+	Version string   `hcl:"version"`
+	Remain  hcl.Body `hcl:",remain"`
+}
+
+type lockfile struct {
+	Providers []*lockedProvider `hcl:"provider,block"`
+	Remain    hcl.Body          `hcl:",remain"`
+}
+
+// loadLockedVersions returns the locked version of providers read from
+// the lock file of module, keyed by their source address (e.g.
+// 'registry.terraform.io/hashicorp/aws'). The lock file is optional.
+func loadLockedVersions(options *Options) (map[string]string, error) {
+	versions := make(map[string]string)
+	if !options.ShowLockedVersions {
+		return versions, nil
+	}
+	filename := filepath.Join(options.Path, lockfileName)
+	if _, err := os.Stat(filename); os.IsNotExist(err) {
+		return versions, nil
+	}
+	file, diags := hclparse.NewParser().ParseHCLFile(filename)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	var lock lockfile
+	if diags := gohcl.DecodeBody(file.Body, nil, &lock); diags.HasErrors() {
+		return nil, diags
+	}
+	for _, provider := range lock.Providers {
+		versions[provider.Source] = provider.Version
+	}
+	return versions, nil
+}
+
+// defaultRegistry is the hostname of providers whose source omits it
+const defaultRegistry = "registry.terraform.io"
+
+// lockedVersion returns the locked version of provider 'name', matching the
+// fully qualified address of its source (e.g. 'hashicorp/aws' is the same as
+// 'registry.terraform.io/hashicorp/aws') with source address of providers in
+// lock file
+func lockedVersion(versions map[string]string, tfmodule *tfconfig.Module, name string) string {
+	source := strings.ToLower(providerSource(tfmodule, name))
+	if strings.Count(source, "/") == 1 {
+		source = defaultRegistry + "/" + source
+	}
+	for address, version := range versions {
+		if strings.ToLower(address) == source {
+			return version
+		}
+	}
+	return ""
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -17,18 +18,31 @@ import (
 )
 
 // LoadWithOptions returns new instance of Module with all the inputs and
-// outputs discovered from provided 'path' containing Terraform config
+// outputs discovered from provided 'path' containing Terraform config.
+// Warnings of parsing the module are discarded, unless in strict mode.
 func LoadWithOptions(options *Options) (*tfconf.Module, error) {
+	module, _, err := LoadWithWarnings(options)
+	return module, err
+}
+
+// LoadWithWarnings returns new instance of Module, the same as LoadWithOptions,
+// along with the warnings of parsing it (e.g. duplicate names of inputs) for
+// the caller to report. They're returned as error instead in strict mode.
+func LoadWithWarnings(options *Options) (*tfconf.Module, tfconfig.Diagnostics, error) {
 	tfmodule, err := loadModule(options.Path)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	warnings := loadWarnings(tfmodule)
+	if len(warnings) != 0 && options.Strict {
+		return nil, nil, warnings
 	}
 	module, err := loadModuleItems(tfmodule, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	sortItems(module, options.SortBy)
-	return module, nil
+	sortItems(module, options)
+	return module, warnings, nil
 }
 
 func loadModule(path string) (*tfconfig.Module, error) {
@@ -36,35 +50,154 @@ func loadModule(path string) (*tfconfig.Module, error) {
 	if diag != nil && diag.HasErrors() {
 		return nil, diag
 	}
+	removeIgnored(module)
 	return module, nil
 }
 
+// loadWarnings returns the warnings of parsing 'tfmodule' (e.g. duplicate
+// names of inputs, outputs or providers)
+func loadWarnings(tfmodule *tfconfig.Module) tfconfig.Diagnostics {
+	warnings := make(tfconfig.Diagnostics, 0)
+	for _, diag := range tfmodule.Diagnostics {
+		if diag.Severity == tfconfig.DiagWarning {
+			warnings = append(warnings, diag)
+		}
+	}
+	return warnings
+}
+
+// removeIgnored removes the variables, outputs, resources, module calls and checks
+// of 'tfmodule' which are preceded by ignore directive comment, so they're
+// excluded from all the formats.
+func removeIgnored(tfmodule *tfconfig.Module) {
+	for name, v := range tfmodule.Variables {
+		if isIgnored(v.Pos) {
+			delete(tfmodule.Variables, name)
+		}
+	}
+	for name, o := range tfmodule.Outputs {
+		if isIgnored(o.Pos) {
+			delete(tfmodule.Outputs, name)
+		}
+	}
+	for _, resources := range []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources} {
+		for key, r := range resources {
+			if isIgnored(r.Pos) {
+				delete(resources, key)
+			}
+		}
+	}
+	for name, m := range tfmodule.ModuleCalls {
+		if isIgnored(m.Pos) {
+			delete(tfmodule.ModuleCalls, name)
+		}
+	}
+	checks := make([]*tfconfig.Check, 0, len(tfmodule.Checks))
+	for _, c := range tfmodule.Checks {
+		if !isIgnored(c.Pos) {
+			checks = append(checks, c)
+		}
+	}
+	tfmodule.Checks = checks
+}
+
 func loadModuleItems(tfmodule *tfconfig.Module, options *Options) (*tfconf.Module, error) {
 	header, err := loadHeader(options)
 	if err != nil {
 		return nil, err
 	}
+	footer, err := loadFooter(options)
+	if err != nil {
+		return nil, err
+	}
+	usage, err := loadUsage(options)
+	if err != nil {
+		return nil, err
+	}
 
-	inputs, required, optional := loadInputs(tfmodule)
+	defaults, err := loadDefaultValues(options)
+	if err != nil {
+		return nil, err
+	}
+	inputs, required, optional := loadInputs(tfmodule, options, defaults)
 	outputs, err := loadOutputs(tfmodule, options)
 	if err != nil {
 		return nil, err
 	}
-	providers := loadProviders(tfmodule)
-	requirements := loadRequirements(tfmodule)
+	if options.OutputValues && options.ShowInputValues {
+		loadInputValues(inputs, outputs)
+	}
+	inputs = filterInputs(inputs, options.IncludeInputs, options.ExcludeInputs)
+	required = filterInputs(required, options.IncludeInputs, options.ExcludeInputs)
+	optional = filterInputs(optional, options.IncludeInputs, options.ExcludeInputs)
+	outputs = filterOutputs(outputs, options.IncludeOutputs, options.ExcludeOutputs)
+	providers, err := loadProviders(tfmodule, options)
+	if err != nil {
+		return nil, err
+	}
+	requirements := loadRequirements(tfmodule, options)
+	resources := loadResources(tfmodule, options)
+	modulecalls := loadModuleCalls(tfmodule, options)
+	moved := loadMoved(tfmodule, options)
+	checks := loadChecks(tfmodule, options)
+	imports := loadImports(tfmodule, options)
 
 	return &tfconf.Module{
 		Header:       header,
+		Footer:       footer,
+		Usage:        usage,
 		Inputs:       inputs,
 		Outputs:      outputs,
 		Providers:    providers,
 		Requirements: requirements,
+		Resources:    resources,
+		ModuleCalls:  modulecalls,
+		Checks:       checks,
+		Moved:        moved,
+		Imports:      imports,
 
 		RequiredInputs: required,
 		OptionalInputs: optional,
 	}, nil
 }
 
+func filterInputs(inputs []*tfconf.Input, include []string, exclude []string) []*tfconf.Input {
+	filtered := make([]*tfconf.Input, 0, len(inputs))
+	for _, input := range inputs {
+		if isIncluded(input.Name, include, exclude) {
+			filtered = append(filtered, input)
+		}
+	}
+	return filtered
+}
+
+func filterOutputs(outputs []*tfconf.Output, include []string, exclude []string) []*tfconf.Output {
+	filtered := make([]*tfconf.Output, 0, len(outputs))
+	for _, output := range outputs {
+		if isIncluded(output.Name, include, exclude) {
+			filtered = append(filtered, output)
+		}
+	}
+	return filtered
+}
+
+// isIncluded indicates if 'name' matches any of 'include' glob patterns,
+// or there isn't any, and doesn't match any of 'exclude' ones
+func isIncluded(name string, include []string, exclude []string) bool {
+	matches := func(patterns []string) bool {
+		for _, pattern := range patterns {
+			if ok, _ := path.Match(pattern, name); ok {
+				return true
+			}
+		}
+		return false
+	}
+	if len(include) > 0 && !matches(include) {
+		return false
+	}
+	return !matches(exclude)
+}
+
 func getFileFormat(filename string) string {
 	if filename == "" {
 		return ""
@@ -75,38 +208,156 @@ func getFileFormat(filename string) string {
 	}
 	return filename[last:]
 }
-func isFileFormatSupported(filename string) (bool, error) {
+func isFileFormatSupported(filename string, section string) (bool, error) {
 	if filename == "" {
-		return false, fmt.Errorf("--header-from value is missing")
+		return false, fmt.Errorf("--%s-from value is missing", section)
 	}
 	switch getFileFormat(filename) {
 	case ".adoc", ".md", ".tf", ".txt":
 		return true, nil
 	}
-	return false, fmt.Errorf("only .adoc, .md, .tf and .txt formats are supported to read header from")
+	return false, fmt.Errorf("only .adoc, .md, .tf and .txt formats are supported to read %s from", section)
 }
 
 func loadHeader(options *Options) (string, error) {
 	if !options.ShowHeader {
 		return "", nil
 	}
-	if ok, err := isFileFormatSupported(options.HeaderFromFile); !ok {
+	// contents of files get concatenated in order, separated by an empty line
+	var header string
+	for _, file := range options.HeaderFromFiles {
+		content, err := loadSection(options, file, "header")
+		if err != nil {
+			return "", err
+		}
+		if content == "" {
+			continue
+		}
+		if header != "" {
+			header = strings.TrimRight(header, "\n") + "\n\n"
+		}
+		header += content
+	}
+	return header, nil
+}
+
+func loadFooter(options *Options) (string, error) {
+	if !options.ShowFooter {
+		return "", nil
+	}
+	return loadSection(options, options.FooterFromFile, "footer")
+}
+
+// loadUsage reads the example file of the module to be shown as its usage,
+// as is and without the trailing empty lines
+func loadUsage(options *Options) (string, error) {
+	if !options.ShowUsage {
+		return "", nil
+	}
+	if options.UsageFromFile == "" {
+		return "", fmt.Errorf("--include-examples value is missing")
+	}
+	filename := filepath.Join(options.Path, options.UsageFromFile)
+	if err := checkConfined(options, filename, "examples"); err != nil {
 		return "", err
 	}
-	filename := filepath.Join(options.Path, options.HeaderFromFile)
-	if info, err := os.Stat(filename); os.IsNotExist(err) || info.IsDir() {
-		if options.HeaderFromFile != "main.tf" {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return "", fmt.Errorf("examples file %s not found", filename)
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("examples file %s is a directory", filename)
+	}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
+
+// checkConfined returns an error if 'filename', read as 'kind' file, is
+// outside of the module while 'kind' is confined to it. Symbolic links
+// are followed, so they can't point out of the module either.
+func checkConfined(options *Options, filename string, kind string) error {
+	if !isConfined(options, kind) || isWithinPath(options.Path, filename) {
+		return nil
+	}
+	return fmt.Errorf("%s file %s is outside of module %s", kind, filename, options.Path)
+}
+
+// isConfined indicates if 'kind' files can only be read from the module
+func isConfined(options *Options, kind string) bool {
+	for _, k := range options.ConfinedFiles {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// isWithinPath indicates if 'filename' resolves to 'root' itself or any
+// file nested in it
+func isWithinPath(root string, filename string) bool {
+	resolve := func(p string) (string, error) {
+		p, err := filepath.Abs(p)
+		if err != nil {
+			return "", err
+		}
+		if resolved, err := filepath.EvalSymlinks(p); err == nil {
+			return resolved, nil
+		}
+		return p, nil
+	}
+	root, err := resolve(root)
+	if err != nil {
+		return false
+	}
+	filename, err = resolve(filename)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(root, filename)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// loadSection reads the content of 'file' to be used as 'section' (i.e.
+// header or footer). The comment block of a '.tf' file delimited by markers
+// or otherwise its leading multi line comment block gets extracted, while
+// other supported formats are read as is.
+func loadSection(options *Options, file string, section string) (string, error) {
+	if ok, err := isFileFormatSupported(file, section); !ok {
+		return "", err
+	}
+	filename := filepath.Join(options.Path, file)
+	if err := checkConfined(options, filename, section); err != nil {
+		return "", err
+	}
+	if info, err := os.Stat(filename); os.IsNotExist(err) || (err == nil && info.IsDir()) {
+		if section != "header" || file != "main.tf" {
+			if err == nil {
+				err = fmt.Errorf("%s file %s is a directory", section, filename)
+			}
 			return "", err // user explicitly asked for a file which doesn't exist
 		}
 		return "", nil // absorb the error to not break workflow of users who don't have 'main.tf at all
+	} else if err != nil {
+		return "", err
 	}
-	if getFileFormat(options.HeaderFromFile) != ".tf" {
+	if getFileFormat(file) != ".tf" {
 		content, err := ioutil.ReadFile(filename)
 		if err != nil {
 			return "", err
 		}
 		return string(content), nil
 	}
+	if content, ok, err := loadMarkedSection(filename); err != nil {
+		return "", err
+	} else if ok {
+		return content, nil
+	}
 	lines := reader.Lines{
 		FileName: filename,
 		LineNum:  -1,
@@ -126,35 +377,107 @@ func loadHeader(options *Options) (string, error) {
 			return line, true
 		},
 	}
-	header, err := lines.Extract()
+	content, err := lines.Extract()
 	if err != nil {
 		return "", err
 	}
-	return strings.Join(header, "\n"), nil
+	return strings.Join(content, "\n"), nil
+}
+
+// markers of the comment block of a '.tf' file to be used as header or footer
+const (
+	sectionStartMarker = "DOC-START"
+	sectionEndMarker   = "DOC-END"
+)
+
+// loadMarkedSection returns the lines of 'filename' between '# DOC-START'
+// and '# DOC-END' comments, with the '#' or '//' of comment lines removed.
+// It returns false if the file doesn't contain both of the markers.
+func loadMarkedSection(filename string) (string, bool, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", false, err
+	}
+	var lines []string
+	for _, line := range strings.Split(strings.Replace(string(content), "\r\n", "\n", -1), "\n") {
+		text, comment := commentText(line)
+		switch {
+		case lines == nil && comment && strings.TrimSpace(text) == sectionStartMarker:
+			lines = make([]string, 0)
+		case lines == nil:
+			continue
+		case comment && strings.TrimSpace(text) == sectionEndMarker:
+			return strings.Trim(strings.Join(lines, "\n"), "\n"), true, nil
+		case comment:
+			lines = append(lines, text)
+		default:
+			lines = append(lines, strings.TrimRight(line, " \t"))
+		}
+	}
+	return "", false, nil
 }
 
-func loadInputs(tfmodule *tfconfig.Module) ([]*tfconf.Input, []*tfconf.Input, []*tfconf.Input) {
+// commentText returns the text of single line comment 'line', starting
+// with '#' or '//', and whether 'line' is such a comment at all.
+func commentText(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+	for _, prefix := range []string{"#", "//"} {
+		if strings.HasPrefix(line, prefix) {
+			line = strings.TrimPrefix(line, prefix)
+			return strings.TrimRight(strings.TrimPrefix(line, " "), " \t"), true
+		}
+	}
+	return "", false
+}
+
+// loadInputs returns all the inputs of module, as well as the required and the
+// optional ones. Default values of inputs are overridden with 'defaults' of the
+// same name, if any, which makes them optional.
+func loadInputs(tfmodule *tfconfig.Module, options *Options, defaults map[string]interface{}) ([]*tfconf.Input, []*tfconf.Input, []*tfconf.Input) {
 	var inputs = make([]*tfconf.Input, 0, len(tfmodule.Variables))
 	var required = make([]*tfconf.Input, 0, len(tfmodule.Variables))
 	var optional = make([]*tfconf.Input, 0, len(tfmodule.Variables))
 
 	for _, input := range tfmodule.Variables {
 		inputDescription := input.Description
-		if inputDescription == "" {
+		if inputDescription == "" && options.ReadComments {
 			inputDescription = loadComments(input.Pos.Filename, input.Pos.Line)
 		}
 
+		inputDefault, inputRequired := input.Default, input.Required
+		if value, ok := defaults[input.Name]; ok {
+			inputDefault, inputRequired = value, false
+		}
+
+		inputType := input.Type
+		if options.NormalizeTypes {
+			inputType = types.NormalizeType(inputType)
+		}
+
 		i := &tfconf.Input{
 			Name:        input.Name,
-			Type:        types.TypeOf(input.Type, input.Default),
+			Type:        types.TypeOf(inputType, inputDefault),
 			Description: types.String(inputDescription),
-			Default:     types.ValueOf(input.Default),
-			Required:    input.Required,
+			Default:     types.ValueOf(types.Coerce(inputType, inputDefault)),
+			Required:    inputRequired,
+			Sensitive:   input.Sensitive,
 			Position: tfconf.Position{
 				Filename: input.Pos.Filename,
 				Line:     input.Pos.Line,
 			},
 		}
+		if options.ShowNullable {
+			nullable := input.Nullable == nil || *input.Nullable
+			i.Nullable = &nullable
+		}
+		if options.ShowValidation {
+			for _, validation := range input.Validations {
+				i.Validations = append(i.Validations, &tfconf.Validation{
+					Condition:    validation.Condition,
+					ErrorMessage: validation.ErrorMessage,
+				})
+			}
+		}
 
 		inputs = append(inputs, i)
 		if i.HasDefault() {
@@ -166,6 +489,20 @@ func loadInputs(tfmodule *tfconfig.Module) ([]*tfconf.Input, []*tfconf.Input, []
 	return inputs, required, optional
 }
 
+// loadInputValues sets the actual value of each input, which has an output
+// of the same name, to the value of that output (e.g. re-exported inputs)
+func loadInputValues(inputs []*tfconf.Input, outputs []*tfconf.Output) {
+	values := make(map[string]*tfconf.Output, len(outputs))
+	for _, output := range outputs {
+		values[output.Name] = output
+	}
+	for _, input := range inputs {
+		if output, ok := values[input.Name]; ok && output.Value != nil {
+			input.Value = output.Value
+		}
+	}
+}
+
 func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Output, error) {
 	outputs := make([]*tfconf.Output, 0, len(tfmodule.Outputs))
 	values := make(map[string]*TerraformOutput)
@@ -178,7 +515,7 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Output,
 	}
 	for _, o := range tfmodule.Outputs {
 		description := o.Description
-		if description == "" {
+		if description == "" && options.ReadComments {
 			description = loadComments(o.Pos.Filename, o.Pos.Line)
 		}
 		output := &tfconf.Output{
@@ -188,14 +525,15 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Output,
 				Filename: o.Pos.Filename,
 				Line:     o.Pos.Line,
 			},
+			Sensitive: o.Sensitive,
 			ShowValue: options.OutputValues,
 		}
-		if options.OutputValues {
-			output.Sensitive = values[output.Name].Sensitive
-			if values[output.Name].Sensitive {
+		if value, ok := values[output.Name]; ok && options.OutputValues {
+			output.Sensitive = output.Sensitive || value.Sensitive
+			if output.Sensitive {
 				output.Value = types.ValueOf(`<sensitive>`)
 			} else {
-				output.Value = types.ValueOf(values[output.Name].Value)
+				output.Value = types.ValueOf(value.Value)
 			}
 		}
 		outputs = append(outputs, output)
@@ -203,29 +541,73 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Output,
 	return outputs, nil
 }
 
+// loadOutputValues returns values of outputs either read from the files of
+// 'options.OutputValuesPaths', merged in order so the values of the later
+// files override the earlier ones of the same name, or by running
+// `terraform output -json` in the module directory if there's none.
 func loadOutputValues(options *Options) (map[string]*TerraformOutput, error) {
-	var out []byte
-	var err error
-	if options.OutputValuesPath == "" {
+	if len(options.OutputValuesPaths) == 0 {
 		cmd := exec.Command("terraform", "output", "-json")
 		cmd.Dir = options.Path
-		if out, err = cmd.Output(); err != nil {
+		out, err := cmd.Output()
+		if err != nil {
 			return nil, fmt.Errorf("caught error while reading the terraform outputs: %v", err)
 		}
-	} else {
-		if out, err = ioutil.ReadFile(options.OutputValuesPath); err != nil {
-			return nil, fmt.Errorf("caught error while reading the terraform outputs file at %s: %v", options.OutputValuesPath, err)
+		return parseOutputValues(out)
+	}
+	values := make(map[string]*TerraformOutput)
+	for _, path := range options.OutputValuesPaths {
+		if err := checkConfined(options, path, "output values"); err != nil {
+			return nil, err
+		}
+		out, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("caught error while reading the terraform outputs file at %s: %v", path, err)
+		}
+		parsed, err := parseOutputValues(out)
+		if err != nil {
+			return nil, fmt.Errorf("caught error while parsing the terraform outputs file at %s: %v", path, err)
+		}
+		for name, value := range parsed {
+			values[name] = value
+		}
+	}
+	return values, nil
+}
+
+// parseOutputValues parses 'content' either generated by `terraform output -json`
+// or by `terraform show -json` of a state or plan file, which is detected by its
+// 'format_version' key
+func parseOutputValues(content []byte) (map[string]*TerraformOutput, error) {
+	var show terraformShow
+	if err := json.Unmarshal(content, &show); err == nil && show.FormatVersion != "" {
+		values := show.Values
+		if values == nil {
+			values = show.PlannedValues
+		}
+		if values == nil || values.Outputs == nil {
+			return make(map[string]*TerraformOutput), nil
 		}
+		return values.Outputs, nil
 	}
 	var terraformOutputs map[string]*TerraformOutput
-	err = json.Unmarshal(out, &terraformOutputs)
-	if err != nil {
+	if err := json.Unmarshal(content, &terraformOutputs); err != nil {
 		return nil, err
 	}
-	return terraformOutputs, err
+	return terraformOutputs, nil
 }
 
-func loadProviders(tfmodule *tfconfig.Module) []*tfconf.Provider {
+func loadProviders(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Provider, error) {
+	locked, err := loadLockedVersions(options)
+	if err != nil {
+		return nil, err
+	}
+	source := func(name string) types.String {
+		if !options.ShowProviderSources {
+			return ""
+		}
+		return types.String(providerSource(tfmodule, name))
+	}
 	resources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
 	discovered := make(map[string]*tfconf.Provider)
 	for _, resource := range resources {
@@ -239,6 +621,8 @@ func loadProviders(tfmodule *tfconfig.Module) []*tfconf.Provider {
 				Name:    r.Provider.Name,
 				Alias:   types.String(r.Provider.Alias),
 				Version: types.String(version),
+				Locked:  types.String(lockedVersion(locked, tfmodule, r.Provider.Name)),
+				Source:  source(r.Provider.Name),
 				Position: tfconf.Position{
 					Filename: r.Pos.Filename,
 					Line:     r.Pos.Line,
@@ -246,19 +630,44 @@ func loadProviders(tfmodule *tfconfig.Module) []*tfconf.Provider {
 			}
 		}
 	}
+	// aliases declared through 'configuration_aliases' are expected to be
+	// passed in by the caller, and may not be used by any resource directly
+	for name, rv := range tfmodule.RequiredProviders {
+		for i, ref := range rv.ConfigurationAliases {
+			key := fmt.Sprintf("%s.%s", name, ref.Alias)
+			if _, ok := discovered[key]; ok {
+				continue
+			}
+			discovered[key] = &tfconf.Provider{
+				Name:    name,
+				Alias:   types.String(ref.Alias),
+				Version: types.String(strings.Join(rv.VersionConstraints, " ")),
+				Locked:  types.String(lockedVersion(locked, tfmodule, name)),
+				Source:  source(name),
+				Position: tfconf.Position{
+					Filename: rv.ConfigurationAliasPos[i].Filename,
+					Line:     rv.ConfigurationAliasPos[i].Line,
+				},
+			}
+		}
+	}
 	providers := make([]*tfconf.Provider, 0, len(discovered))
 	for _, provider := range discovered {
 		providers = append(providers, provider)
 	}
-	return providers
+	return providers, nil
 }
 
-func loadRequirements(tfmodule *tfconfig.Module) []*tfconf.Requirement {
+func loadRequirements(tfmodule *tfconfig.Module, options *Options) []*tfconf.Requirement {
 	var requirements = make([]*tfconf.Requirement, 0)
-	for _, core := range tfmodule.RequiredCore {
+	for i, core := range tfmodule.RequiredCore {
+		if !options.ShowTerraformCore {
+			break
+		}
 		requirements = append(requirements, &tfconf.Requirement{
-			Name:    "terraform",
-			Version: types.String(core),
+			Name:     "terraform",
+			Version:  types.String(core),
+			Position: requirementPosition(tfmodule, tfmodule.RequiredCorePos, i),
 		})
 	}
 	names := make([]string, 0, len(tfmodule.RequiredProviders))
@@ -267,17 +676,183 @@ func loadRequirements(tfmodule *tfconfig.Module) []*tfconf.Requirement {
 	}
 	sort.Strings(names)
 	for _, name := range names {
-		for _, version := range tfmodule.RequiredProviders[name].VersionConstraints {
+		for i, version := range tfmodule.RequiredProviders[name].VersionConstraints {
 			requirements = append(requirements, &tfconf.Requirement{
-				Name:    name,
-				Version: types.String(version),
+				Name:     name,
+				Version:  types.String(version),
+				Position: requirementPosition(tfmodule, tfmodule.RequiredProviders[name].VersionPos, i),
 			})
 		}
 	}
 	return requirements
 }
 
+// requirementPosition returns i-th item of 'positions', where a version
+// constraint is declared at, with file path relative to the module
+func requirementPosition(tfmodule *tfconfig.Module, positions []tfconfig.SourcePos, i int) tfconf.Position {
+	if i >= len(positions) {
+		return tfconf.Position{}
+	}
+	filename := positions[i].Filename
+	if rel, err := filepath.Rel(tfmodule.Path, filename); err == nil {
+		filename = rel
+	}
+	return tfconf.Position{
+		Filename: filename,
+		Line:     positions[i].Line,
+	}
+}
+
+func loadResources(tfmodule *tfconfig.Module, options *Options) []*tfconf.Resource {
+	sources := make([]map[string]*tfconfig.Resource, 0, 2)
+	if options.ShowResources {
+		sources = append(sources, tfmodule.ManagedResources)
+	}
+	if options.ShowDataSources {
+		sources = append(sources, tfmodule.DataResources)
+	}
+	resources := make([]*tfconf.Resource, 0, len(tfmodule.ManagedResources)+len(tfmodule.DataResources))
+	for _, resource := range sources {
+		for _, r := range resource {
+			provider := r.Provider.Name
+			if r.Provider.Alias != "" {
+				provider = fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
+			}
+			resource := &tfconf.Resource{
+				Type:     r.Type,
+				Name:     r.Name,
+				Mode:     r.Mode.String(),
+				Provider: provider,
+				Position: tfconf.Position{
+					Filename: r.Pos.Filename,
+					Line:     r.Pos.Line,
+				},
+			}
+			if options.LinkResources {
+				resource.URL = resourceURL(tfmodule, r)
+			}
+			resources = append(resources, resource)
+		}
+	}
+	return resources
+}
+
+func loadModuleCalls(tfmodule *tfconfig.Module, options *Options) []*tfconf.ModuleCall {
+	if !options.ShowModules {
+		return make([]*tfconf.ModuleCall, 0)
+	}
+	modulecalls := make([]*tfconf.ModuleCall, 0, len(tfmodule.ModuleCalls))
+	for _, m := range tfmodule.ModuleCalls {
+		source := m.Source
+		if options.NormalizeModuleSources {
+			source = normalizeSource(options.Path, source)
+		}
+		modulecall := &tfconf.ModuleCall{
+			Name:    m.Name,
+			Source:  source,
+			Version: types.String(m.Version),
+			Position: tfconf.Position{
+				Filename: m.Pos.Filename,
+				Line:     m.Pos.Line,
+			},
+		}
+		if options.ShowDependsOn {
+			modulecall.DependsOn = m.DependsOn
+		}
+		modulecalls = append(modulecalls, modulecall)
+	}
+	return modulecalls
+}
+
+// loadMoved returns 'moved' blocks of the module in the order they're
+// declared, which is the order of refactorings in most cases
+func loadMoved(tfmodule *tfconfig.Module, options *Options) []*tfconf.Moved {
+	if !options.ShowMoved {
+		return nil
+	}
+	moved := make([]*tfconf.Moved, 0, len(tfmodule.Moved))
+	for _, m := range tfmodule.Moved {
+		moved = append(moved, &tfconf.Moved{
+			From: m.From,
+			To:   m.To,
+			Position: tfconf.Position{
+				Filename: m.Pos.Filename,
+				Line:     m.Pos.Line,
+			},
+		})
+	}
+	return moved
+}
+
+// ignoreDirective is the comment which excludes the declaration
+// immediately after it from the document (e.g. '# tfdocs:ignore')
+const ignoreDirective = "tfdocs:ignore"
+
+// isIgnored indicates if the comments immediately before a declaration
+// at 'pos' contain ignore directive.
+func isIgnored(pos tfconfig.SourcePos) bool {
+	for _, line := range readComments(pos.Filename, pos.Line) {
+		if line == ignoreDirective {
+			return true
+		}
+	}
+	return false
+}
+
+// loadChecks returns 'check' blocks of the module, with their assertions,
+// in the order they're declared
+func loadChecks(tfmodule *tfconfig.Module, options *Options) []*tfconf.Check {
+	if !options.ShowChecks {
+		return nil
+	}
+	checks := make([]*tfconf.Check, 0, len(tfmodule.Checks))
+	for _, c := range tfmodule.Checks {
+		check := &tfconf.Check{
+			Name:       c.Name,
+			Assertions: make([]*tfconf.Assertion, 0, len(c.Assertions)),
+			Position: tfconf.Position{
+				Filename: c.Pos.Filename,
+				Line:     c.Pos.Line,
+			},
+		}
+		for _, a := range c.Assertions {
+			check.Assertions = append(check.Assertions, &tfconf.Assertion{
+				Condition:    a.Condition,
+				ErrorMessage: a.ErrorMessage,
+			})
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// loadImports returns 'import' blocks of the module in the order they're
+// declared
+func loadImports(tfmodule *tfconfig.Module, options *Options) []*tfconf.Import {
+	if !options.ShowImports {
+		return nil
+	}
+	imports := make([]*tfconf.Import, 0, len(tfmodule.Imports))
+	for _, i := range tfmodule.Imports {
+		imports = append(imports, &tfconf.Import{
+			To: i.To,
+			ID: i.ID,
+			Position: tfconf.Position{
+				Filename: i.Pos.Filename,
+				Line:     i.Pos.Line,
+			},
+		})
+	}
+	return imports
+}
+
 func loadComments(filename string, lineNum int) string {
+	return strings.Join(readComments(filename, lineNum), " ")
+}
+
+// readComments returns the lines of the comment immediately before
+// 'lineNum' of 'filename', without their '#' or '//' prefix.
+func readComments(filename string, lineNum int) []string {
 	lines := reader.Lines{
 		FileName: filename,
 		LineNum:  lineNum,
@@ -294,18 +869,32 @@ func loadComments(filename string, lineNum int) string {
 	}
 	comment, err := lines.Extract()
 	if err != nil {
-		return "" // absorb the error, we don't need to bubble it up or break the execution
+		return nil // absorb the error, we don't need to bubble it up or break the execution
 	}
-	return strings.Join(comment, " ")
+	return comment
 }
 
-func sortItems(tfmodule *tfconf.Module, sortby *SortBy) {
-	if sortby.Type {
+func sortItems(tfmodule *tfconf.Module, options *Options) {
+	inputsby := options.SortBy
+	if options.SortInputsBy != nil {
+		inputsby = options.SortInputsBy
+	}
+	outputsby := options.SortBy
+	if options.SortOutputsBy != nil {
+		outputsby = options.SortOutputsBy
+	}
+	sortby := options.SortBy
+
+	if len(inputsby.Keys) != 0 {
+		sort.Sort(inputsSortedByKeys{inputs: tfmodule.Inputs, keys: inputsby.Keys})
+		sort.Sort(inputsSortedByKeys{inputs: tfmodule.RequiredInputs, keys: inputsby.Keys})
+		sort.Sort(inputsSortedByKeys{inputs: tfmodule.OptionalInputs, keys: inputsby.Keys})
+	} else if inputsby.Type {
 		sort.Sort(inputsSortedByType(tfmodule.Inputs))
 		sort.Sort(inputsSortedByType(tfmodule.RequiredInputs))
 		sort.Sort(inputsSortedByType(tfmodule.OptionalInputs))
-	} else if sortby.Name {
-		if sortby.Required {
+	} else if inputsby.Name {
+		if inputsby.Required {
 			sort.Sort(inputsSortedByRequired(tfmodule.Inputs))
 			sort.Sort(inputsSortedByRequired(tfmodule.RequiredInputs))
 			sort.Sort(inputsSortedByRequired(tfmodule.OptionalInputs))
@@ -320,7 +909,7 @@ func sortItems(tfmodule *tfconf.Module, sortby *SortBy) {
 		sort.Sort(inputsSortedByPosition(tfmodule.OptionalInputs))
 	}
 
-	if sortby.Name || sortby.Type {
+	if outputsby.Name || outputsby.Type {
 		sort.Sort(outputsSortedByName(tfmodule.Outputs))
 	} else {
 		sort.Sort(outputsSortedByPosition(tfmodule.Outputs))
@@ -331,4 +920,16 @@ func sortItems(tfmodule *tfconf.Module, sortby *SortBy) {
 	} else {
 		sort.Sort(providersSortedByPosition(tfmodule.Providers))
 	}
+
+	if sortby.Name || sortby.Type {
+		sort.Sort(resourcesSortedByType(tfmodule.Resources))
+	} else {
+		sort.Sort(resourcesSortedByPosition(tfmodule.Resources))
+	}
+
+	if sortby.Name || sortby.Type {
+		sort.Sort(modulecallsSortedByName(tfmodule.ModuleCalls))
+	} else {
+		sort.Sort(modulecallsSortedByPosition(tfmodule.ModuleCalls))
+	}
 }
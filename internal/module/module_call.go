@@ -0,0 +1,69 @@
+package module
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+type modulecallsSortedByName []*tfconf.ModuleCall
+
+func (a modulecallsSortedByName) Len() int           { return len(a) }
+func (a modulecallsSortedByName) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+func (a modulecallsSortedByName) Less(i, j int) bool { return a[i].Name < a[j].Name }
+
+type modulecallsSortedByPosition []*tfconf.ModuleCall
+
+func (a modulecallsSortedByPosition) Len() int      { return len(a) }
+func (a modulecallsSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a modulecallsSortedByPosition) Less(i, j int) bool {
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
+}
+
+// isLocalSource returns true if 'source' of a module call is a local path
+func isLocalSource(source string) bool {
+	return strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")
+}
+
+// normalizeSource returns local 'source' of a module call, declared in the
+// module at 'path', relative to the root of the repository the module is in,
+// which is the closest directory containing '.git'. It's returned as is if
+// it's not local, no repository is found or it points out of the repository.
+func normalizeSource(path string, source string) string {
+	if !isLocalSource(source) {
+		return source
+	}
+	dir, err := filepath.Abs(path)
+	if err != nil {
+		return source
+	}
+	root := findRepoRoot(dir)
+	if root == "" {
+		return source
+	}
+	rel, err := filepath.Rel(root, filepath.Join(dir, source))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return source
+	}
+	return filepath.ToSlash(rel)
+}
+
+// findRepoRoot returns the closest directory to 'dir', itself included, which
+// contains '.git', or empty string if there's none
+func findRepoRoot(dir string) string {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
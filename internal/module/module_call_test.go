@@ -0,0 +1,71 @@
+package module
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+func TestModuleCallsSortedByName(t *testing.T) {
+	assert := assert.New(t)
+	modulecalls := sampleModuleCalls()
+
+	sort.Sort(modulecallsSortedByName(modulecalls))
+
+	expected := []string{"a", "b", "c", "d"}
+	actual := make([]string, len(modulecalls))
+
+	for k, m := range modulecalls {
+		actual[k] = m.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func TestModuleCallsSortedByPosition(t *testing.T) {
+	assert := assert.New(t)
+	modulecalls := sampleModuleCalls()
+
+	sort.Sort(modulecallsSortedByPosition(modulecalls))
+
+	expected := []string{"d", "b", "a", "c"}
+	actual := make([]string, len(modulecalls))
+
+	for k, m := range modulecalls {
+		actual[k] = m.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func sampleModuleCalls() []*tfconf.ModuleCall {
+	return []*tfconf.ModuleCall{
+		{
+			Name:     "b",
+			Source:   "foo/bar",
+			Version:  "1.2.3",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 21},
+		},
+		{
+			Name:     "c",
+			Source:   "./modules/c",
+			Version:  "",
+			Position: tfconf.Position{Filename: "foo/modules.tf", Line: 3},
+		},
+		{
+			Name:     "d",
+			Source:   "git::https://domain.com/d.git",
+			Version:  "",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 5},
+		},
+		{
+			Name:     "a",
+			Source:   "foo/baz",
+			Version:  ">= 2.0",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 39},
+		},
+	}
+}
@@ -1,10 +1,13 @@
 package module
 
 import (
+	"fmt"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/internal/tfconfig"
 )
 
 func TestLoadModuleWithOptions(t *testing.T) {
@@ -23,6 +26,128 @@ func TestLoadModuleWithOptions(t *testing.T) {
 	assert.Equal(true, module.HasRequirements())
 }
 
+func TestLoadModuleWithFilters(t *testing.T) {
+	assert := assert.New(t)
+
+	options, _ := NewOptions().With(&Options{
+		Path:           filepath.Join("testdata", "full-example"),
+		IncludeInputs:  []string{"A", "B", "C"},
+		ExcludeInputs:  []string{"B"},
+		ExcludeOutputs: []string{"[AB]"},
+	})
+	module, err := LoadWithOptions(options)
+
+	assert.Nil(err)
+	assert.Equal(2, len(module.Inputs))
+	assert.Equal("A", module.Inputs[0].Name)
+	assert.Equal("C", module.Inputs[1].Name)
+	assert.Equal(len(module.Inputs), len(module.RequiredInputs)+len(module.OptionalInputs))
+	assert.Equal(1, len(module.Outputs))
+	assert.Equal("C", module.Outputs[0].Name)
+}
+
+func TestLoadModuleIgnoreDirective(t *testing.T) {
+	assert := assert.New(t)
+
+	options, _ := NewOptions().With(&Options{
+		Path: filepath.Join("testdata", "ignore-directive"),
+	})
+	module, err := LoadWithOptions(options)
+
+	assert.Nil(err)
+	assert.Equal(1, len(module.Inputs))
+	assert.Equal("public", module.Inputs[0].Name)
+	assert.Equal(1, len(module.Outputs))
+	assert.Equal("public", module.Outputs[0].Name)
+	assert.Equal(1, len(module.Resources))
+	assert.Equal("null_resource", module.Resources[0].Type)
+	assert.Equal("public", module.Resources[0].Name)
+	assert.Equal(0, len(module.ModuleCalls))
+}
+
+func TestLoadModuleDuplicateNames(t *testing.T) {
+	assert := assert.New(t)
+
+	options, _ := NewOptions().With(&Options{
+		Path: filepath.Join("testdata", "duplicate-names"),
+	})
+	module, warnings, err := LoadWithWarnings(options)
+
+	assert.Nil(err)
+	assert.Equal(1, len(module.Inputs))
+	assert.Equal("The name of the resources.", string(module.Inputs[0].Description))
+	assert.Equal(1, len(module.Outputs))
+	assert.NotEmpty(warnings)
+	assert.Contains(warnings.Error(), "Duplicate variable declaration")
+}
+
+func TestLoadModuleDuplicateNamesStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	options, _ := NewOptions().With(&Options{
+		Path:   filepath.Join("testdata", "duplicate-names"),
+		Strict: true,
+	})
+	_, warnings, err := LoadWithWarnings(options)
+
+	assert.Nil(warnings)
+
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "Duplicate variable declaration")
+}
+
+func TestIsIncluded(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     string
+		include  []string
+		exclude  []string
+		expected bool
+	}{
+		{
+			name:     "no patterns",
+			item:     "foo",
+			include:  []string{},
+			exclude:  []string{},
+			expected: true,
+		},
+		{
+			name:     "matching include pattern",
+			item:     "aws_region",
+			include:  []string{"gcp_*", "aws_*"},
+			exclude:  []string{},
+			expected: true,
+		},
+		{
+			name:     "no matching include pattern",
+			item:     "gcp_region",
+			include:  []string{"aws_*"},
+			exclude:  []string{},
+			expected: false,
+		},
+		{
+			name:     "matching exclude pattern",
+			item:     "internal_id",
+			include:  []string{},
+			exclude:  []string{"internal_*"},
+			expected: false,
+		},
+		{
+			name:     "exclude takes precedence over include",
+			item:     "aws_internal",
+			include:  []string{"aws_*"},
+			exclude:  []string{"*_internal"},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, isIncluded(tt.item, tt.include, tt.exclude))
+		})
+	}
+}
+
 func TestLoadModule(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -153,7 +278,7 @@ func TestIsFileFormatSupported(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
-			actual, err := isFileFormatSupported(tt.filename)
+			actual, err := isFileFormatSupported(tt.filename, "header")
 			if tt.wantErr {
 				assert.NotNil(err)
 				assert.Equal(tt.errText, err.Error())
@@ -190,6 +315,22 @@ func TestLoadHeader(t *testing.T) {
 			wantErr:  false,
 			errText:  "",
 		},
+		{
+			name:     "load module header from path between markers",
+			path:     "full-example",
+			header:   "doc-markers.tf",
+			expected: "Marked Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2\n\n    indented line",
+			wantErr:  false,
+			errText:  "",
+		},
+		{
+			name:     "load module header from path without end marker",
+			path:     "full-example",
+			header:   "doc-unclosed.tf",
+			expected: "Leading Header",
+			wantErr:  false,
+			errText:  "",
+		},
 		{
 			name:     "load module header from path",
 			path:     "full-example",
@@ -230,6 +371,14 @@ func TestLoadHeader(t *testing.T) {
 			wantErr:  true,
 			errText:  "stat testdata/full-example/non-existent.tf: no such file or directory",
 		},
+		{
+			name:     "load module header from path",
+			path:     "full-example",
+			header:   "doc-dir.md",
+			expected: "",
+			wantErr:  true,
+			errText:  "header file testdata/full-example/doc-dir.md is a directory",
+		},
 		{
 			name:     "load module header from path",
 			path:     "full-example",
@@ -266,7 +415,57 @@ func TestLoadHeader(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
-			options := &Options{Path: filepath.Join("testdata", tt.path), HeaderFromFile: tt.header, ShowHeader: true}
+			options := &Options{Path: filepath.Join("testdata", tt.path), HeaderFromFiles: []string{tt.header}, ShowHeader: true}
+			actual, err := loadHeader(options)
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errText, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLoadHeaderMultipleFiles(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		headers  []string
+		expected string
+		wantErr  bool
+		errText  string
+	}{
+		{
+			name:     "load module header from multiple files",
+			path:     "full-example",
+			headers:  []string{"doc.txt", "doc.tf"},
+			expected: "# Custom Header\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2\n\nCustom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2",
+			wantErr:  false,
+			errText:  "",
+		},
+		{
+			name:     "load module header from multiple files",
+			path:     "no-inputs",
+			headers:  []string{"main.tf", "doc.md"},
+			expected: "",
+			wantErr:  true,
+			errText:  "stat testdata/no-inputs/doc.md: no such file or directory",
+		},
+		{
+			name:     "load module header from no files",
+			path:     "full-example",
+			headers:  []string{},
+			expected: "",
+			wantErr:  false,
+			errText:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := &Options{Path: filepath.Join("testdata", tt.path), HeaderFromFiles: tt.headers, ShowHeader: true}
 			actual, err := loadHeader(options)
 			if tt.wantErr {
 				assert.NotNil(err)
@@ -279,6 +478,176 @@ func TestLoadHeader(t *testing.T) {
 	}
 }
 
+func TestLoadFooter(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		footer   string
+		show     bool
+		expected string
+		wantErr  bool
+		errText  string
+	}{
+		{
+			name:     "load module footer from path",
+			path:     "full-example",
+			footer:   "doc.md",
+			show:     true,
+			expected: "# Custom Header\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2\n",
+			wantErr:  false,
+		},
+		{
+			name:     "load module footer from path",
+			path:     "full-example",
+			footer:   "doc.tf",
+			show:     true,
+			expected: "Custom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2",
+			wantErr:  false,
+		},
+		{
+			name:     "load module footer from path",
+			path:     "full-example",
+			footer:   "doc.md",
+			show:     false,
+			expected: "",
+			wantErr:  false,
+		},
+		{
+			name:     "load module footer from path",
+			path:     "full-example",
+			footer:   "main.tf",
+			show:     true,
+			expected: "Example of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2\n\nEven inline **formatting** in _here_ is possible.\nand some [link](https://domain.com/)",
+			wantErr:  false,
+		},
+		{
+			name:     "load module footer from path",
+			path:     "full-example",
+			footer:   "non-existent.md",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "stat testdata/full-example/non-existent.md: no such file or directory",
+		},
+		{
+			name:     "load module footer from path",
+			path:     "no-inputs",
+			footer:   "main.tf",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "stat testdata/no-inputs/main.tf: no such file or directory",
+		},
+		{
+			name:     "load module footer from path",
+			path:     "full-example",
+			footer:   "",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "--footer-from value is missing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := &Options{Path: filepath.Join("testdata", tt.path), FooterFromFile: tt.footer, ShowFooter: tt.show}
+			actual, err := loadFooter(options)
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errText, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLoadUsage(t *testing.T) {
+	tests := []struct {
+		name     string
+		usage    string
+		show     bool
+		confined bool
+		expected string
+		wantErr  bool
+		errText  string
+	}{
+		{
+			name:     "load usage from file as is",
+			usage:    "doc.tf",
+			show:     true,
+			expected: "/**\n * Custom Header:\n *\n * Example of 'foo_bar' module in `foo_bar.tf`.\n *\n * - list item 1\n * - list item 2\n */",
+			wantErr:  false,
+		},
+		{
+			name:     "load usage not shown",
+			usage:    "doc.tf",
+			show:     false,
+			expected: "",
+			wantErr:  false,
+		},
+		{
+			name:     "load usage from non-existent file",
+			usage:    "examples/main.tf",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "examples file testdata/full-example/examples/main.tf not found",
+		},
+		{
+			name:     "load usage from directory",
+			usage:    "doc-dir.md",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "examples file testdata/full-example/doc-dir.md is a directory",
+		},
+		{
+			name:     "load usage without file",
+			usage:    "",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "--include-examples value is missing",
+		},
+		{
+			name:     "load usage from outside of module",
+			usage:    "../provider-sources/main.tf",
+			show:     true,
+			expected: "terraform {\n  required_providers {\n    aws = {\n      source  = \"acme/aws\"\n      version = \">= 4.0\"\n    }\n  }\n}\n\nresource \"aws_instance\" \"default\" {}\n\nresource \"null_resource\" \"default\" {}",
+			wantErr:  false,
+		},
+		{
+			name:     "load usage from outside of confined module",
+			usage:    "../provider-sources/main.tf",
+			show:     true,
+			confined: true,
+			expected: "",
+			wantErr:  true,
+			errText:  "examples file testdata/provider-sources/main.tf is outside of module testdata/full-example",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := &Options{Path: filepath.Join("testdata", "full-example"), UsageFromFile: tt.usage, ShowUsage: tt.show}
+			if tt.confined {
+				options.ConfinedFiles = []string{"examples"}
+			}
+			actual, err := loadUsage(options)
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errText, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
 func TestLoadInputs(t *testing.T) {
 	type expected struct {
 		inputs    int
@@ -330,8 +699,9 @@ func TestLoadInputs(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
+			options := NewOptions()
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			inputs, requireds, optionals := loadInputs(module)
+			inputs, requireds, optionals := loadInputs(module, options, nil)
 
 			assert.Equal(tt.expected.inputs, len(inputs))
 			assert.Equal(tt.expected.requireds, len(requireds))
@@ -340,6 +710,65 @@ func TestLoadInputs(t *testing.T) {
 	}
 }
 
+func TestLoadReadComments(t *testing.T) {
+	tests := []struct {
+		name         string
+		readComments bool
+		inputs       map[string]string
+		outputs      map[string]string
+	}{
+		{
+			name:         "load descriptions from comments",
+			readComments: true,
+			inputs: map[string]string{
+				"A": "A Description in multiple lines",
+				"C": "C description",
+				"D": "D description",
+			},
+			outputs: map[string]string{
+				"A": "A description",
+				"B": "B description",
+			},
+		},
+		{
+			name:         "load descriptions without comments",
+			readComments: false,
+			inputs: map[string]string{
+				"A": "",
+				"C": "C description",
+				"D": "",
+			},
+			outputs: map[string]string{
+				"A": "A description",
+				"B": "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.ReadComments = tt.readComments
+			module, _ := loadModule(filepath.Join("testdata", "full-example"))
+
+			inputs, _, _ := loadInputs(module, options, nil)
+			for _, input := range inputs {
+				if expected, ok := tt.inputs[input.Name]; ok {
+					assert.Equal(expected, string(input.Description))
+				}
+			}
+
+			outputs, err := loadOutputs(module, options)
+			assert.Nil(err)
+			for _, output := range outputs {
+				if expected, ok := tt.outputs[output.Name]; ok {
+					assert.Equal(expected, string(output.Description))
+				}
+			}
+		})
+	}
+}
+
 func TestLoadOutputs(t *testing.T) {
 	type expected struct {
 		outputs int
@@ -402,13 +831,31 @@ func TestLoadOutputsValues(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name:       "load module outputs with values from path",
+			name:       "load module outputs with values from state file",
 			path:       "full-example",
-			outputPath: "no-file.json",
-			expected:   expected{},
-			wantErr:    true,
-		},
-		{
+			outputPath: "output-values-state.json",
+			expected: expected{
+				outputs: 3,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "load module outputs with values from plan file",
+			path:       "full-example",
+			outputPath: "output-values-plan.json",
+			expected: expected{
+				outputs: 3,
+			},
+			wantErr: false,
+		},
+		{
+			name:       "load module outputs with values from path",
+			path:       "full-example",
+			outputPath: "no-file.json",
+			expected:   expected{},
+			wantErr:    true,
+		},
+		{
 			name:       "load module outputs with values from path",
 			path:       "no-outputs",
 			outputPath: "no-file.json",
@@ -420,8 +867,8 @@ func TestLoadOutputsValues(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			options, _ := NewOptions().With(&Options{
-				OutputValues:     true,
-				OutputValuesPath: filepath.Join("testdata", tt.path, tt.outputPath),
+				OutputValues:      true,
+				OutputValuesPaths: []string{filepath.Join("testdata", tt.path, tt.outputPath)},
 			})
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
 			outputs, err := loadOutputs(module, options)
@@ -440,6 +887,267 @@ func TestLoadOutputsValues(t *testing.T) {
 	}
 }
 
+func TestLoadInputValues(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputValues bool
+		expected    map[string]string
+	}{
+		{
+			name:        "load module inputs with values of outputs",
+			inputValues: true,
+			expected: map[string]string{
+				"A": `"a value"`,
+				"B": `"b value"`,
+				"C": `"<sensitive>"`,
+				"D": "",
+			},
+		},
+		{
+			name:        "load module inputs without values of outputs",
+			inputValues: false,
+			expected: map[string]string{
+				"A": "",
+				"B": "",
+				"C": "",
+				"D": "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options, _ := NewOptions().With(&Options{
+				Path:              filepath.Join("testdata", "full-example"),
+				OutputValues:      true,
+				OutputValuesPaths: []string{filepath.Join("testdata", "full-example", "output-values.json")},
+				ShowInputValues:   tt.inputValues,
+			})
+			module, err := LoadWithOptions(options)
+			assert.Nil(err)
+
+			for _, input := range module.Inputs {
+				if expected, ok := tt.expected[input.Name]; ok {
+					assert.Equal(expected, input.GetActualValue())
+				}
+			}
+		})
+	}
+}
+
+func TestLoadDefaultValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		confined  bool
+		requireds int
+		expected  map[string]string
+		wantErr   bool
+	}{
+		{
+			name:      "load module inputs without default values file",
+			file:      "",
+			requireds: 2,
+			expected: map[string]string{
+				"A": "",
+				"B": `"b"`,
+				"D": `"d"`,
+			},
+			wantErr: false,
+		},
+		{
+			name:      "load module inputs with default values from tfvars file",
+			file:      "default-values.tfvars",
+			requireds: 1,
+			expected: map[string]string{
+				"A": `"a"`,
+				"B": `"b"`,
+				"D": "[\n  \"d1\",\n  \"d2\"\n]",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "load module inputs with default values from tfvars json file",
+			file:      "default-values.tfvars.json",
+			requireds: 1,
+			expected: map[string]string{
+				"A": `"a"`,
+				"B": `"b"`,
+				"D": "[\n  \"d1\",\n  \"d2\"\n]",
+			},
+			wantErr: false,
+		},
+		{
+			name:      "load module inputs with default values from missing file",
+			file:      "not-exist.tfvars",
+			requireds: 0,
+			expected:  map[string]string{},
+			wantErr:   true,
+		},
+		{
+			name:      "load module inputs with default values from directory",
+			file:      "doc-dir.md",
+			requireds: 0,
+			expected:  map[string]string{},
+			wantErr:   true,
+		},
+		{
+			name:      "load module inputs with default values from outside of confined module",
+			file:      "../normalize-types/variables.tf",
+			confined:  true,
+			requireds: 0,
+			expected:  map[string]string{},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path := filepath.Join("testdata", "full-example")
+			options, _ := NewOptions().With(&Options{
+				Path: path,
+			})
+			if tt.confined {
+				options.ConfinedFiles = []string{"default values"}
+			}
+			if tt.file != "" {
+				options.DefaultValuesPath = tt.file
+			}
+			module, err := LoadWithOptions(options)
+			if tt.wantErr {
+				assert.NotNil(err)
+				return
+			}
+			assert.Nil(err)
+			assert.Equal(tt.requireds, len(module.RequiredInputs))
+
+			for _, input := range module.Inputs {
+				if expected, ok := tt.expected[input.Name]; ok {
+					assert.Equal(expected, input.GetValue())
+				}
+			}
+		})
+	}
+}
+
+func TestLoadOutputValuesMerged(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []string
+		expected map[string]string
+	}{
+		{
+			name:  "load output values from one file",
+			files: []string{"output-values.json"},
+			expected: map[string]string{
+				"A": "a value",
+				"B": "b value",
+				"C": "sensitive-c",
+			},
+		},
+		{
+			name:  "load output values merged from files in order",
+			files: []string{"output-values.json", "output-values-override.json"},
+			expected: map[string]string{
+				"A": "a value",
+				"B": "b override",
+				"C": "sensitive-c",
+				"D": "d value",
+			},
+		},
+		{
+			name:  "load output values merged from files in reverse order",
+			files: []string{"output-values-override.json", "output-values.json"},
+			expected: map[string]string{
+				"A": "a value",
+				"B": "b value",
+				"C": "sensitive-c",
+				"D": "d value",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			for _, file := range tt.files {
+				options.OutputValuesPaths = append(options.OutputValuesPaths, filepath.Join("testdata", "full-example", file))
+			}
+			values, err := loadOutputValues(options)
+			assert.Nil(err)
+
+			actual := make(map[string]string)
+			for name, value := range values {
+				actual[name] = value.Value.(string)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestParseOutputValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		values  map[string]interface{}
+		wantErr bool
+	}{
+		{
+			name:    "parse terraform output",
+			content: `{"A": {"sensitive": false, "type": "string", "value": "a value"}}`,
+			values:  map[string]interface{}{"A": "a value"},
+			wantErr: false,
+		},
+		{
+			name:    "parse terraform state",
+			content: `{"format_version": "0.1", "values": {"outputs": {"A": {"sensitive": false, "value": "a value"}}}}`,
+			values:  map[string]interface{}{"A": "a value"},
+			wantErr: false,
+		},
+		{
+			name:    "parse terraform plan",
+			content: `{"format_version": "0.1", "planned_values": {"outputs": {"A": {"sensitive": false, "value": "a value"}}}}`,
+			values:  map[string]interface{}{"A": "a value"},
+			wantErr: false,
+		},
+		{
+			name:    "parse terraform state without outputs",
+			content: `{"format_version": "0.1", "values": {"root_module": {}}}`,
+			values:  map[string]interface{}{},
+			wantErr: false,
+		},
+		{
+			name:    "parse terraform output named format_version",
+			content: `{"format_version": {"sensitive": false, "type": "string", "value": "0.1"}}`,
+			values:  map[string]interface{}{"format_version": "0.1"},
+			wantErr: false,
+		},
+		{
+			name:    "parse invalid content",
+			content: `[]`,
+			values:  nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			outputs, err := parseOutputValues([]byte(tt.content))
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+				values := make(map[string]interface{})
+				for name, output := range outputs {
+					values[name] = output.Value
+				}
+				assert.Equal(tt.values, values)
+			}
+		})
+	}
+}
+
 func TestLoadProviders(t *testing.T) {
 	type expected struct {
 		providers int
@@ -457,24 +1165,534 @@ func TestLoadProviders(t *testing.T) {
 			},
 		},
 		{
-			name: "load module providers from path",
+			name: "load module without providers",
 			path: "no-providers",
 			expected: expected{
 				providers: 0,
 			},
 		},
+		{
+			name: "load module providers of aliases in configuration_aliases",
+			path: "provider-aliases",
+			expected: expected{
+				providers: 3,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			providers := loadProviders(module)
+			providers, err := loadProviders(module, NewOptions())
+			assert.Nil(err)
 
 			assert.Equal(tt.expected.providers, len(providers))
 		})
 	}
 }
 
+func TestLoadProvidersLocked(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		show     bool
+		expected map[string]string
+	}{
+		{
+			name: "load locked versions of providers",
+			path: "full-example",
+			show: true,
+			expected: map[string]string{
+				"aws":  "3.10.0",
+				"null": "",
+				"tls":  "3.0.0",
+			},
+		},
+		{
+			name: "load locked versions of providers",
+			path: "full-example",
+			show: false,
+			expected: map[string]string{
+				"aws":  "",
+				"null": "",
+				"tls":  "",
+			},
+		},
+		{
+			name:     "load locked versions of providers",
+			path:     "no-providers",
+			show:     true,
+			expected: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.Path = filepath.Join("testdata", tt.path)
+			options.ShowLockedVersions = tt.show
+			module, _ := loadModule(options.Path)
+			providers, err := loadProviders(module, options)
+			assert.Nil(err)
+
+			actual := make(map[string]string)
+			for _, provider := range providers {
+				actual[provider.Name] = string(provider.Locked)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadProvidersSources(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		show     bool
+		expected map[string]string
+	}{
+		{
+			name: "load sources of providers",
+			path: "full-example",
+			show: true,
+			expected: map[string]string{
+				"aws":  "hashicorp/aws",
+				"null": "hashicorp/null",
+				"tls":  "hashicorp/tls",
+			},
+		},
+		{
+			name: "load declared sources of providers",
+			path: "provider-sources",
+			show: true,
+			expected: map[string]string{
+				"aws":  "acme/aws",
+				"null": "hashicorp/null",
+			},
+		},
+		{
+			name: "load sources of providers",
+			path: "full-example",
+			show: false,
+			expected: map[string]string{
+				"aws":  "",
+				"null": "",
+				"tls":  "",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.Path = filepath.Join("testdata", tt.path)
+			options.ShowProviderSources = tt.show
+			module, _ := loadModule(options.Path)
+			providers, err := loadProviders(module, options)
+			assert.Nil(err)
+
+			actual := make(map[string]string)
+			for _, provider := range providers {
+				actual[provider.Name] = string(provider.Source)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadProvidersConfigurationAliases(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "provider-aliases"))
+	providers, err := loadProviders(module, NewOptions())
+	assert.Nil(err)
+
+	actual := make(map[string]string)
+	for _, provider := range providers {
+		actual[provider.FullName()] = string(provider.Version)
+	}
+	assert.Equal(map[string]string{
+		"aws":      ">= 3.0",
+		"aws.east": ">= 3.0",
+		"aws.west": ">= 3.0",
+	}, actual)
+}
+
+func TestLockedVersion(t *testing.T) {
+	versions := map[string]string{
+		"registry.terraform.io/hashicorp/aws": "3.10.0",
+		"registry.terraform.io/someorg/aws":   "2.0.0",
+		"example.com/hashicorp/aws":           "4.0.0",
+		"registry.terraform.io/foo/bar":       "1.0.0",
+	}
+	tests := []struct {
+		name     string
+		provider string
+		source   string
+		expected string
+	}{
+		{
+			name:     "provider with default source",
+			provider: "aws",
+			source:   "",
+			expected: "3.10.0",
+		},
+		{
+			name:     "provider with explicit source",
+			provider: "baz",
+			source:   "foo/bar",
+			expected: "1.0.0",
+		},
+		{
+			name:     "provider with fully qualified source",
+			provider: "baz",
+			source:   "registry.terraform.io/foo/bar",
+			expected: "1.0.0",
+		},
+		{
+			name:     "provider sharing name with another namespace",
+			provider: "aws",
+			source:   "someorg/aws",
+			expected: "2.0.0",
+		},
+		{
+			name:     "provider sharing namespace and name with another registry",
+			provider: "aws",
+			source:   "example.com/hashicorp/aws",
+			expected: "4.0.0",
+		},
+		{
+			name:     "provider with source in different case",
+			provider: "aws",
+			source:   "HashiCorp/AWS",
+			expected: "3.10.0",
+		},
+		{
+			name:     "provider not in lock file",
+			provider: "null",
+			source:   "",
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			tfmodule := &tfconfig.Module{
+				RequiredProviders: map[string]*tfconfig.ProviderRequirement{
+					tt.provider: {Source: tt.source},
+				},
+			}
+			assert.Equal(tt.expected, lockedVersion(versions, tfmodule, tt.provider))
+		})
+	}
+}
+
+func TestLoadRequirements(t *testing.T) {
+	type expected struct {
+		requirements []string
+	}
+	tests := []struct {
+		name      string
+		path      string
+		terraform bool
+		expected  expected
+	}{
+		{
+			name:      "load module requirements from path",
+			path:      "full-example",
+			terraform: true,
+			expected: expected{
+				requirements: []string{"terraform >= 0.12 main.tf:12", "aws >= 2.15.0 main.tf:14"},
+			},
+		},
+		{
+			name:      "load module requirements from path",
+			path:      "no-providers",
+			terraform: true,
+			expected: expected{
+				requirements: []string{},
+			},
+		},
+		{
+			name:      "load module requirements without terraform",
+			path:      "full-example",
+			terraform: false,
+			expected: expected{
+				requirements: []string{"aws >= 2.15.0 main.tf:14"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.ShowTerraformCore = tt.terraform
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			requirements := loadRequirements(module, options)
+
+			actual := make([]string, 0, len(requirements))
+			for _, r := range requirements {
+				actual = append(actual, fmt.Sprintf("%s %s %s", r.Name, r.Version, r.Position))
+			}
+			assert.Equal(tt.expected.requirements, actual)
+		})
+	}
+}
+
+func TestLoadResources(t *testing.T) {
+	type expected struct {
+		resources int
+		data      int
+	}
+	tests := []struct {
+		name     string
+		path     string
+		show     bool
+		showData bool
+		expected expected
+	}{
+		{
+			name:     "load module resources from path",
+			path:     "full-example",
+			show:     true,
+			showData: true,
+			expected: expected{
+				resources: 3,
+				data:      1,
+			},
+		},
+		{
+			name:     "load module resources from path",
+			path:     "full-example",
+			show:     false,
+			showData: false,
+			expected: expected{
+				resources: 0,
+				data:      0,
+			},
+		},
+		{
+			name:     "load module managed resources from path",
+			path:     "full-example",
+			show:     true,
+			showData: false,
+			expected: expected{
+				resources: 2,
+				data:      0,
+			},
+		},
+		{
+			name:     "load module data resources from path",
+			path:     "full-example",
+			show:     false,
+			showData: true,
+			expected: expected{
+				resources: 1,
+				data:      1,
+			},
+		},
+		{
+			name:     "load module resources from path",
+			path:     "no-providers",
+			show:     true,
+			showData: true,
+			expected: expected{
+				resources: 0,
+				data:      0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.ShowResources = tt.show
+			options.ShowDataSources = tt.showData
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			resources := loadResources(module, options)
+
+			data := 0
+			for _, r := range resources {
+				if r.Mode == "data" {
+					data++
+				}
+			}
+			assert.Equal(tt.expected.resources, len(resources))
+			assert.Equal(tt.expected.data, data)
+		})
+	}
+}
+
+func TestLoadModuleCalls(t *testing.T) {
+	type expected struct {
+		modules int
+	}
+	tests := []struct {
+		name     string
+		path     string
+		show     bool
+		expected expected
+	}{
+		{
+			name: "load module calls from path",
+			path: "full-example",
+			show: true,
+			expected: expected{
+				modules: 2,
+			},
+		},
+		{
+			name: "load module calls from path",
+			path: "full-example",
+			show: false,
+			expected: expected{
+				modules: 0,
+			},
+		},
+		{
+			name: "load module calls from path",
+			path: "no-providers",
+			show: true,
+			expected: expected{
+				modules: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.ShowModules = tt.show
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			modulecalls := loadModuleCalls(module, options)
+
+			assert.Equal(tt.expected.modules, len(modulecalls))
+		})
+	}
+}
+
+func TestLoadModuleCallsNormalizeSources(t *testing.T) {
+	tests := []struct {
+		name      string
+		normalize bool
+		expected  map[string]string
+	}{
+		{
+			name:      "load module calls with sources as declared",
+			normalize: false,
+			expected: map[string]string{
+				"foo": "bar",
+				"baz": "./modules/baz",
+			},
+		},
+		{
+			name:      "load module calls with sources relative to repository root",
+			normalize: true,
+			expected: map[string]string{
+				"foo": "bar",
+				"baz": "internal/module/testdata/full-example/modules/baz",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.Path = filepath.Join("testdata", "full-example")
+			options.NormalizeModuleSources = tt.normalize
+			module, _ := loadModule(options.Path)
+			modulecalls := loadModuleCalls(module, options)
+
+			actual := make(map[string]string)
+			for _, m := range modulecalls {
+				actual[m.Name] = m.Source
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadModuleCallsDependsOn(t *testing.T) {
+	tests := []struct {
+		name     string
+		show     bool
+		expected map[string][]string
+	}{
+		{
+			name: "load module calls without depends_on",
+			show: false,
+			expected: map[string][]string{
+				"foo": nil,
+				"baz": nil,
+			},
+		},
+		{
+			name: "load module calls with depends_on",
+			show: true,
+			expected: map[string][]string{
+				"foo": nil,
+				"baz": {"module.foo"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.ShowDependsOn = tt.show
+			module, _ := loadModule(filepath.Join("testdata", "full-example"))
+			modulecalls := loadModuleCalls(module, options)
+
+			actual := make(map[string][]string)
+			for _, m := range modulecalls {
+				actual[m.Name] = m.DependsOn
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestLoadInputsNormalizeTypes(t *testing.T) {
+	tests := []struct {
+		name      string
+		normalize bool
+		expected  map[string]string
+	}{
+		{
+			name:      "load module inputs with types as declared",
+			normalize: false,
+			expected: map[string]string{
+				"legacy": "string",
+				"object": "object({\n    name    = string,\n    \"tags\"  = map( string )\n  })",
+				"tuple":  "tuple([string,number])",
+			},
+		},
+		{
+			name:      "load module inputs with normalized types",
+			normalize: true,
+			expected: map[string]string{
+				"legacy": "string",
+				"object": "object({ name = string, tags = map(string) })",
+				"tuple":  "tuple([string, number])",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := NewOptions()
+			options.NormalizeTypes = tt.normalize
+			module, _ := loadModule(filepath.Join("testdata", "normalize-types"))
+			inputs, _, _ := loadInputs(module, options, nil)
+
+			actual := make(map[string]string)
+			for _, i := range inputs {
+				actual[i.Name] = string(i.Type)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestLoadComments(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -535,6 +1753,8 @@ func TestSortItems(t *testing.T) {
 		optional  []string
 		outputs   []string
 		providers []string
+		resources []string
+		modules   []string
 	}
 	tests := []struct {
 		name     string
@@ -552,6 +1772,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"D", "B", "E", "C", "G"},
 				outputs:   []string{"C", "A", "B"},
 				providers: []string{"tls", "aws", "null"},
+				resources: []string{"baz", "current", "foo"},
+				modules:   []string{"foo", "baz"},
 			},
 		},
 		{
@@ -564,6 +1786,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"B", "C", "D", "E", "G"},
 				outputs:   []string{"A", "B", "C"},
 				providers: []string{"aws", "null", "tls"},
+				resources: []string{"current", "foo", "baz"},
+				modules:   []string{"baz", "foo"},
 			},
 		},
 		{
@@ -576,6 +1800,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"D", "B", "E", "C", "G"},
 				outputs:   []string{"C", "A", "B"},
 				providers: []string{"tls", "aws", "null"},
+				resources: []string{"baz", "current", "foo"},
+				modules:   []string{"foo", "baz"},
 			},
 		},
 		{
@@ -588,6 +1814,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
 				providers: []string{"aws", "null", "tls"},
+				resources: []string{"current", "foo", "baz"},
+				modules:   []string{"baz", "foo"},
 			},
 		},
 		{
@@ -600,6 +1828,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"B", "C", "D", "E", "G"},
 				outputs:   []string{"A", "B", "C"},
 				providers: []string{"aws", "null", "tls"},
+				resources: []string{"current", "foo", "baz"},
+				modules:   []string{"baz", "foo"},
 			},
 		},
 		{
@@ -612,6 +1842,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
 				providers: []string{"aws", "null", "tls"},
+				resources: []string{"current", "foo", "baz"},
+				modules:   []string{"baz", "foo"},
 			},
 		},
 		{
@@ -624,6 +1856,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
 				providers: []string{"aws", "null", "tls"},
+				resources: []string{"current", "foo", "baz"},
+				modules:   []string{"baz", "foo"},
 			},
 		},
 		{
@@ -636,6 +1870,8 @@ func TestSortItems(t *testing.T) {
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
 				providers: []string{"aws", "null", "tls"},
+				resources: []string{"current", "foo", "baz"},
+				modules:   []string{"baz", "foo"},
 			},
 		},
 	}
@@ -651,7 +1887,7 @@ func TestSortItems(t *testing.T) {
 			module, err := loadModuleItems(tfmodule, options)
 
 			assert.Nil(err)
-			sortItems(module, tt.sort)
+			sortItems(module, &Options{SortBy: tt.sort})
 
 			for i, v := range module.Inputs {
 				assert.Equal(tt.expected.inputs[i], v.Name)
@@ -668,6 +1904,88 @@ func TestSortItems(t *testing.T) {
 			for i, v := range module.Providers {
 				assert.Equal(tt.expected.providers[i], v.Name)
 			}
+			for i, v := range module.Resources {
+				assert.Equal(tt.expected.resources[i], v.Name)
+			}
+			for i, v := range module.ModuleCalls {
+				assert.Equal(tt.expected.modules[i], v.Name)
+			}
+		})
+	}
+}
+
+func TestSortItemsPerSection(t *testing.T) {
+	type expected struct {
+		inputs    []string
+		outputs   []string
+		providers []string
+	}
+	tests := []struct {
+		name     string
+		sort     *SortBy
+		inputs   *SortBy
+		outputs  *SortBy
+		expected expected
+	}{
+		{
+			name:    "sort inputs by required and outputs by name",
+			sort:    &SortBy{Name: false, Required: false, Type: false},
+			inputs:  &SortBy{Name: true, Required: true, Type: false},
+			outputs: &SortBy{Name: true, Required: false, Type: false},
+			expected: expected{
+				inputs:    []string{"A", "F", "B", "C", "D", "E", "G"},
+				outputs:   []string{"A", "B", "C"},
+				providers: []string{"tls", "aws", "null"},
+			},
+		},
+		{
+			name:    "sort inputs by type and keep outputs in position",
+			sort:    &SortBy{Name: true, Required: false, Type: false},
+			inputs:  &SortBy{Name: true, Required: false, Type: true},
+			outputs: &SortBy{Name: false, Required: false, Type: false},
+			expected: expected{
+				inputs:    []string{"A", "F", "G", "B", "C", "D", "E"},
+				outputs:   []string{"C", "A", "B"},
+				providers: []string{"aws", "null", "tls"},
+			},
+		},
+		{
+			name:    "fall back to sort of other items",
+			sort:    &SortBy{Name: true, Required: true, Type: false},
+			inputs:  nil,
+			outputs: nil,
+			expected: expected{
+				inputs:    []string{"A", "F", "B", "C", "D", "E", "G"},
+				outputs:   []string{"A", "B", "C"},
+				providers: []string{"aws", "null", "tls"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			path := filepath.Join("testdata", "full-example")
+			options, _ := NewOptions().With(&Options{
+				Path:          path,
+				SortBy:        tt.sort,
+				SortInputsBy:  tt.inputs,
+				SortOutputsBy: tt.outputs,
+			})
+			tfmodule, _ := loadModule(path)
+			module, err := loadModuleItems(tfmodule, options)
+
+			assert.Nil(err)
+			sortItems(module, options)
+
+			for i, v := range module.Inputs {
+				assert.Equal(tt.expected.inputs[i], v.Name)
+			}
+			for i, v := range module.Outputs {
+				assert.Equal(tt.expected.outputs[i], v.Name)
+			}
+			for i, v := range module.Providers {
+				assert.Equal(tt.expected.providers[i], v.Name)
+			}
 		})
 	}
 }
@@ -12,27 +12,88 @@ type SortBy struct {
 	Name     bool
 	Required bool
 	Type     bool
+	Keys     []string // criteria [name, required, type] compared in turn, takes precedence over the ones above if set
 }
 
 // Options contains required options to load a Module from path
 type Options struct {
-	Path             string
-	ShowHeader       bool
-	HeaderFromFile   string
-	SortBy           *SortBy
-	OutputValues     bool
-	OutputValuesPath string
+	Path                   string
+	ShowFooter             bool
+	ShowHeader             bool
+	ShowResources          bool
+	ShowDataSources        bool
+	ShowModules            bool
+	ShowMoved              bool
+	ShowChecks             bool
+	ShowImports            bool
+	ShowUsage              bool
+	ShowLockedVersions     bool
+	ShowProviderSources    bool
+	ShowTerraformCore      bool // include the version constraint of Terraform core in requirements
+	LinkResources          bool // link resources and data sources to their documentation on Terraform Registry
+	HeaderFromFiles        []string
+	FooterFromFile         string
+	UsageFromFile          string   // example of using the module, relative to its path (e.g. 'examples/basic/main.tf')
+	IncludeInputs          []string // glob patterns of inputs to document, all if empty
+	ExcludeInputs          []string // glob patterns of inputs not to document
+	IncludeOutputs         []string // glob patterns of outputs to document, all if empty
+	ExcludeOutputs         []string // glob patterns of outputs not to document
+	SortBy                 *SortBy
+	SortInputsBy           *SortBy // falls back to SortBy if nil
+	SortOutputsBy          *SortBy // falls back to SortBy if nil
+	OutputValues           bool
+	OutputValuesPaths      []string // files to read output values from and merge in order, 'terraform output' is run if empty
+	DefaultValuesPath      string
+	ShowInputValues        bool     // annotate inputs with values of outputs of the same name, requires OutputValues
+	ShowNullable           bool     // annotate inputs with whether they accept 'null' as their value
+	ShowValidation         bool     // annotate inputs with their 'validation' rules
+	ShowDependsOn          bool     // annotate module calls with their 'depends_on' addresses
+	ReadComments           bool     // use comments preceding inputs and outputs without description as their description
+	NormalizeModuleSources bool     // render local sources of module calls relative to the root of their repository
+	NormalizeTypes         bool     // render types of inputs in a canonical form, regardless of their spacing and quoting
+	Strict                 bool     // fail on warnings of parsing the module (e.g. duplicate names) instead of returning them
+	ConfinedFiles          []string // kinds of files (e.g. 'header') only read if nested in Path, as not set by the user of a module fetched from a remote source
 }
 
 // NewOptions returns new instance of Options
 func NewOptions() *Options {
 	return &Options{
-		Path:             "",
-		ShowHeader:       true,
-		HeaderFromFile:   "main.tf",
-		SortBy:           &SortBy{Name: false, Required: false, Type: false},
-		OutputValues:     false,
-		OutputValuesPath: "",
+		Path:                   "",
+		ShowFooter:             false,
+		ShowHeader:             true,
+		ShowResources:          true,
+		ShowDataSources:        true,
+		ShowModules:            true,
+		ShowMoved:              false,
+		ShowChecks:             false,
+		ShowImports:            false,
+		ShowUsage:              false,
+		ShowLockedVersions:     false,
+		ShowProviderSources:    false,
+		ShowTerraformCore:      true,
+		LinkResources:          false,
+		HeaderFromFiles:        []string{"main.tf"},
+		FooterFromFile:         "",
+		UsageFromFile:          "",
+		IncludeInputs:          []string{},
+		ExcludeInputs:          []string{},
+		IncludeOutputs:         []string{},
+		ExcludeOutputs:         []string{},
+		SortBy:                 &SortBy{Name: false, Required: false, Type: false},
+		SortInputsBy:           nil,
+		SortOutputsBy:          nil,
+		OutputValues:           false,
+		OutputValuesPaths:      []string{},
+		DefaultValuesPath:      "",
+		ShowInputValues:        false,
+		ShowNullable:           false,
+		ShowValidation:         false,
+		ShowDependsOn:          false,
+		ReadComments:           true,
+		NormalizeModuleSources: false,
+		NormalizeTypes:         false,
+		Strict:                 false,
+		ConfinedFiles:          []string{},
 	}
 }
 
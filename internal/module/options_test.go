@@ -13,7 +13,7 @@ func TestOptionsWith(t *testing.T) {
 
 	assert.Equal(options.Path, "")
 	assert.Equal(options.OutputValues, false)
-	assert.Equal(options.OutputValuesPath, "")
+	assert.Equal(options.OutputValuesPaths, []string{})
 
 	_, err1 := options.With(&Options{
 		Path: "/path/to/foo",
@@ -22,17 +22,17 @@ func TestOptionsWith(t *testing.T) {
 
 	assert.Equal(options.Path, "/path/to/foo")
 	assert.Equal(options.OutputValues, false)
-	assert.Equal(options.OutputValuesPath, "")
+	assert.Equal(options.OutputValuesPaths, []string{})
 
 	_, err2 := options.With(&Options{
-		OutputValues:     true,
-		OutputValuesPath: "/path/to/output/values",
+		OutputValues:      true,
+		OutputValuesPaths: []string{"/path/to/output/values"},
 	})
 	assert.Nil(err2)
 
 	assert.Equal(options.Path, "/path/to/foo")
 	assert.Equal(options.OutputValues, true)
-	assert.Equal(options.OutputValuesPath, "/path/to/output/values")
+	assert.Equal(options.OutputValuesPaths, []string{"/path/to/output/values"})
 
 	_, err3 := options.With(&Options{
 		Path:         "",
@@ -59,9 +59,9 @@ func TestOptionsWithOverwrite(t *testing.T) {
 	options := NewOptions()
 
 	assert.Equal(options.Path, "")
-	assert.Equal(options.HeaderFromFile, "main.tf")
+	assert.Equal(options.HeaderFromFiles, []string{"main.tf"})
 	assert.Equal(options.OutputValues, false)
-	assert.Equal(options.OutputValuesPath, "")
+	assert.Equal(options.OutputValuesPaths, []string{})
 
 	_, err1 := options.With(&Options{
 		Path: "/path/to/foo",
@@ -69,21 +69,21 @@ func TestOptionsWithOverwrite(t *testing.T) {
 	assert.Nil(err1)
 
 	assert.Equal(options.Path, "/path/to/foo")
-	assert.Equal(options.HeaderFromFile, "main.tf")
+	assert.Equal(options.HeaderFromFiles, []string{"main.tf"})
 	assert.Equal(options.OutputValues, false)
-	assert.Equal(options.OutputValuesPath, "")
+	assert.Equal(options.OutputValuesPaths, []string{})
 
 	_, err2 := options.WithOverwrite(&Options{
-		HeaderFromFile:   "doc.tf",
-		OutputValues:     true,
-		OutputValuesPath: "/path/to/output/values",
+		HeaderFromFiles:   []string{"doc.tf"},
+		OutputValues:      true,
+		OutputValuesPaths: []string{"/path/to/output/values"},
 	})
 	assert.Nil(err2)
 
 	assert.Equal(options.Path, "/path/to/foo")
-	assert.Equal(options.HeaderFromFile, "doc.tf")
+	assert.Equal(options.HeaderFromFiles, []string{"doc.tf"})
 	assert.Equal(options.OutputValues, true)
-	assert.Equal(options.OutputValuesPath, "/path/to/output/values")
+	assert.Equal(options.OutputValuesPaths, []string{"/path/to/output/values"})
 
 	_, err3 := options.WithOverwrite(&Options{
 		Path:         "",
@@ -92,9 +92,9 @@ func TestOptionsWithOverwrite(t *testing.T) {
 	assert.Nil(err3)
 
 	assert.NotEqual(options.Path, "")
-	assert.Equal(options.HeaderFromFile, "doc.tf")
+	assert.Equal(options.HeaderFromFiles, []string{"doc.tf"})
 	assert.NotEqual(options.OutputValues, false)
-	assert.Equal(options.OutputValuesPath, "/path/to/output/values")
+	assert.Equal(options.OutputValuesPaths, []string{"/path/to/output/values"})
 }
 
 func TestOptionsWithNilOverwrite(t *testing.T) {
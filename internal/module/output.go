@@ -11,6 +11,20 @@ type TerraformOutput struct {
 	Value     interface{} `json:"value"`
 }
 
+// terraformValues is the 'values' or 'planned_values' of `terraform show -json`
+type terraformValues struct {
+	Outputs map[string]*TerraformOutput `json:"outputs"`
+}
+
+// terraformShow is used for unmarshalling `terraform show -json` of a state
+// file or a plan file into, which contain output values in 'values' and
+// 'planned_values' respectively
+type terraformShow struct {
+	FormatVersion string           `json:"format_version"`
+	Values        *terraformValues `json:"values"`
+	PlannedValues *terraformValues `json:"planned_values"`
+}
+
 type outputsSortedByName []*tfconf.Output
 
 func (a outputsSortedByName) Len() int      { return len(a) }
@@ -24,5 +38,8 @@ type outputsSortedByPosition []*tfconf.Output
 func (a outputsSortedByPosition) Len() int      { return len(a) }
 func (a outputsSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 func (a outputsSortedByPosition) Less(i, j int) bool {
-	return a[i].Position.Filename < a[j].Position.Filename || a[i].Position.Line < a[j].Position.Line
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
 }
@@ -1,9 +1,49 @@
 package module
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/terraform-docs/internal/tfconfig"
 	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
 
+// providerSource returns the source of provider 'name' declared in
+// 'required_providers', or 'hashicorp/<name>' which Terraform implies
+// for providers without any source.
+func providerSource(tfmodule *tfconfig.Module, name string) string {
+	if rp, ok := tfmodule.RequiredProviders[name]; ok && rp.Source != "" {
+		return rp.Source
+	}
+	return "hashicorp/" + name
+}
+
+// resourceURL returns the URL of the documentation of resource or data source
+// 'r' on Terraform Registry, derived from the source of its provider, or empty
+// string if the provider isn't published there (e.g. private registry or
+// built-in 'terraform' provider).
+func resourceURL(tfmodule *tfconfig.Module, r *tfconfig.Resource) string {
+	name := r.Provider.Name
+	if name == "terraform" || !strings.HasPrefix(r.Type, name+"_") {
+		return ""
+	}
+	parts := strings.Split(providerSource(tfmodule, name), "/")
+	if len(parts) == 3 {
+		if !strings.EqualFold(parts[0], defaultRegistry) {
+			return ""
+		}
+		parts = parts[1:]
+	}
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return ""
+	}
+	kind := "resources"
+	if r.Mode == tfconfig.DataResourceMode {
+		kind = "data-sources"
+	}
+	return fmt.Sprintf("https://registry.terraform.io/providers/%s/%s/latest/docs/%s/%s", strings.ToLower(parts[0]), strings.ToLower(parts[1]), kind, strings.TrimPrefix(r.Type, name+"_"))
+}
+
 type providersSortedByName []*tfconf.Provider
 
 func (a providersSortedByName) Len() int      { return len(a) }
@@ -17,5 +57,8 @@ type providersSortedByPosition []*tfconf.Provider
 func (a providersSortedByPosition) Len() int      { return len(a) }
 func (a providersSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 func (a providersSortedByPosition) Less(i, j int) bool {
-	return a[i].Position.Filename < a[j].Position.Filename || a[i].Position.Line < a[j].Position.Line
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
 }
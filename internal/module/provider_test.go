@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/segmentio/terraform-docs/internal/tfconfig"
 	"github.com/segmentio/terraform-docs/internal/types"
 	"github.com/segmentio/terraform-docs/pkg/tfconf"
 )
@@ -88,3 +89,50 @@ func sampleProviders() []*tfconf.Provider {
 		},
 	}
 }
+
+func TestResourceURL(t *testing.T) {
+	tfmodule := &tfconfig.Module{
+		RequiredProviders: map[string]*tfconfig.ProviderRequirement{
+			"google":   {Source: "registry.terraform.io/hashicorp/google"},
+			"datadog":  {Source: "DataDog/datadog"},
+			"internal": {Source: "registry.example.com/acme/internal"},
+		},
+	}
+	tests := []struct {
+		name     string
+		resource *tfconfig.Resource
+		expected string
+	}{
+		{
+			name:     "implied source",
+			resource: &tfconfig.Resource{Mode: tfconfig.ManagedResourceMode, Type: "aws_instance", Provider: tfconfig.ProviderRef{Name: "aws"}},
+			expected: "https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/instance",
+		},
+		{
+			name:     "data source with registry host",
+			resource: &tfconfig.Resource{Mode: tfconfig.DataResourceMode, Type: "google_project", Provider: tfconfig.ProviderRef{Name: "google"}},
+			expected: "https://registry.terraform.io/providers/hashicorp/google/latest/docs/data-sources/project",
+		},
+		{
+			name:     "partner provider",
+			resource: &tfconfig.Resource{Mode: tfconfig.ManagedResourceMode, Type: "datadog_monitor", Provider: tfconfig.ProviderRef{Name: "datadog"}},
+			expected: "https://registry.terraform.io/providers/datadog/datadog/latest/docs/resources/monitor",
+		},
+		{
+			name:     "private registry",
+			resource: &tfconfig.Resource{Mode: tfconfig.ManagedResourceMode, Type: "internal_thing", Provider: tfconfig.ProviderRef{Name: "internal"}},
+			expected: "",
+		},
+		{
+			name:     "built-in provider",
+			resource: &tfconfig.Resource{Mode: tfconfig.ManagedResourceMode, Type: "terraform_data", Provider: tfconfig.ProviderRef{Name: "terraform"}},
+			expected: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, resourceURL(tfmodule, tt.resource))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package module
+
+import (
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+type resourcesSortedByType []*tfconf.Resource
+
+func (a resourcesSortedByType) Len() int      { return len(a) }
+func (a resourcesSortedByType) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a resourcesSortedByType) Less(i, j int) bool {
+	if a[i].FullType() == a[j].FullType() {
+		return a[i].Name < a[j].Name
+	}
+	return a[i].FullType() < a[j].FullType()
+}
+
+type resourcesSortedByPosition []*tfconf.Resource
+
+func (a resourcesSortedByPosition) Len() int      { return len(a) }
+func (a resourcesSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a resourcesSortedByPosition) Less(i, j int) bool {
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
+}
@@ -0,0 +1,82 @@
+package module
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/segmentio/terraform-docs/pkg/tfconf"
+)
+
+func TestResourcesSortedByType(t *testing.T) {
+	assert := assert.New(t)
+	resources := sampleResources()
+
+	sort.Sort(resourcesSortedByType(resources))
+
+	expected := []string{"a_foo.a", "a_foo.b", "b_foo.a", "data.a_foo.c", "data.c_foo.a"}
+	actual := make([]string, len(resources))
+
+	for k, r := range resources {
+		actual[k] = r.FullType() + "." + r.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func TestResourcesSortedByPosition(t *testing.T) {
+	assert := assert.New(t)
+	resources := sampleResources()
+
+	sort.Sort(resourcesSortedByPosition(resources))
+
+	expected := []string{"data.c_foo.a", "a_foo.b", "b_foo.a", "a_foo.a", "data.a_foo.c"}
+	actual := make([]string, len(resources))
+
+	for k, r := range resources {
+		actual[k] = r.FullType() + "." + r.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func sampleResources() []*tfconf.Resource {
+	return []*tfconf.Resource{
+		{
+			Type:     "a_foo",
+			Name:     "b",
+			Mode:     "managed",
+			Provider: "a",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 21},
+		},
+		{
+			Type:     "b_foo",
+			Name:     "a",
+			Mode:     "managed",
+			Provider: "b",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 25},
+		},
+		{
+			Type:     "a_foo",
+			Name:     "c",
+			Mode:     "data",
+			Provider: "a",
+			Position: tfconf.Position{Filename: "foo/variables.tf", Line: 3},
+		},
+		{
+			Type:     "c_foo",
+			Name:     "a",
+			Mode:     "data",
+			Provider: "c.alias",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 5},
+		},
+		{
+			Type:     "a_foo",
+			Name:     "a",
+			Mode:     "managed",
+			Provider: "a",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 39},
+		},
+	}
+}
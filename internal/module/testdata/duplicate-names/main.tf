@@ -0,0 +1,9 @@
+variable "name" {
+  description = "The name of the resources."
+  type        = string
+}
+
+output "id" {
+  description = "The id of the resources."
+  value       = "id"
+}
@@ -0,0 +1,4 @@
+variable "name" {
+  description = "The name of the resources, declared once again."
+  type        = string
+}
@@ -0,0 +1,17 @@
+# This file is maintained automatically by "terraform init".
+# Manual edits may be lost in future updates.
+
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "3.10.0"
+  constraints = ">= 2.15.0"
+  hashes = [
+    "h1:Q0/pKu0dDrGQsuaZb69pOmw5rzVm8FS3RWvLcQRBAhM=",
+  ]
+}
+
+provider "registry.terraform.io/hashicorp/tls" {
+  version = "3.0.0"
+  hashes = [
+    "h1:LtCEW5v1E5Eo49+kQOsKHRYf9Hc8ZR0jTpK+mXszPHs=",
+  ]
+}
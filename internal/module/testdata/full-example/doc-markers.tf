@@ -0,0 +1,18 @@
+/**
+ * Leading Header
+ */
+
+# Some unrelated comment
+
+# DOC-START
+# Marked Header:
+#
+# Example of 'foo_bar' module in `foo_bar.tf`.
+#
+// - list item 1
+// - list item 2
+#
+#     indented line
+# DOC-END
+
+# Another unrelated comment
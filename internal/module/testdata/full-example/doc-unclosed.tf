@@ -0,0 +1,6 @@
+/**
+ * Leading Header
+ */
+
+# DOC-START
+# Marked Header
@@ -22,3 +22,14 @@ data "aws_caller_identity" "current" {
 }
 
 resource "null_resource" "foo" {}
+
+module "foo" {
+  source  = "bar"
+  version = "1.2.3"
+}
+
+module "baz" {
+  source = "./modules/baz"
+
+  depends_on = [module.foo]
+}
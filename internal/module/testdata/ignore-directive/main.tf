@@ -0,0 +1,32 @@
+variable "public" {
+  description = "Documented input."
+}
+
+# Only used by the internal pipeline.
+# tfdocs:ignore
+variable "internal" {
+  default = ""
+}
+
+// tfdocs:ignore
+output "internal" {
+  value = var.internal
+}
+
+output "public" {
+  description = "Documented output."
+  value       = var.public
+}
+
+resource "null_resource" "public" {}
+
+# tfdocs:ignore
+resource "null_resource" "internal" {}
+
+# tfdocs:ignore
+data "null_data_source" "internal" {}
+
+# tfdocs:ignore
+module "internal" {
+  source = "./modules/internal"
+}
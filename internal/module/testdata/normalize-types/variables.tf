@@ -0,0 +1,14 @@
+variable "legacy" {
+  type = "string"
+}
+
+variable "object" {
+  type = object({
+    name    = string,
+    "tags"  = map( string )
+  })
+}
+
+variable "tuple" {
+  type = tuple([string,number])
+}
@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    aws = {
+      source                = "hashicorp/aws"
+      version               = ">= 3.0"
+      configuration_aliases = [aws.east, aws.west]
+    }
+  }
+}
+
+resource "aws_instance" "default" {}
+
+resource "aws_instance" "east" {
+  provider = aws.east
+}
@@ -0,0 +1,12 @@
+terraform {
+  required_providers {
+    aws = {
+      source  = "acme/aws"
+      version = ">= 4.0"
+    }
+  }
+}
+
+resource "aws_instance" "default" {}
+
+resource "null_resource" "default" {}
@@ -0,0 +1,71 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+)
+
+// loadDefaultValues returns the values of variables read from the tfvars
+// file at 'DefaultValuesPath', relative to the module unless it's absolute,
+// keyed by their name, either in HCL syntax (e.g. 'prod.tfvars') or in JSON
+// syntax (e.g. 'prod.tfvars.json').
+func loadDefaultValues(options *Options) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	if options.DefaultValuesPath == "" {
+		return values, nil
+	}
+	filename := options.DefaultValuesPath
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(options.Path, filename)
+	}
+	if err := checkConfined(options, filename, "default values"); err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return nil, fmt.Errorf("default values file %s not found", filename)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("default values file %s is a directory", filename)
+	}
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	parser := hclparse.NewParser()
+	if strings.HasSuffix(filename, ".json") {
+		file, diags = parser.ParseJSONFile(filename)
+	} else {
+		file, diags = parser.ParseHCLFile(filename)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	for name, attr := range attrs {
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		// same as default values of variables, converted into plain Go
+		// value through its JSON encoding to not deal with cty here
+		valJSON, err := ctyjson.Marshal(val, val.Type())
+		if err != nil {
+			return nil, fmt.Errorf("caught error while reading value of %s from %s: %v", name, filename, err)
+		}
+		var value interface{}
+		if err := json.Unmarshal(valJSON, &value); err != nil {
+			return nil, err
+		}
+		values[name] = value
+	}
+	return values, nil
+}
@@ -14,7 +14,9 @@ type TestSettings struct {
 // Settings returns TestSettings instance with predefined set of print.Settings
 func Settings() *TestSettings {
 	shared := &print.Settings{
-		EscapePipe: true,
+		EscapePipe:  true,
+		IndentLevel: 2,
+		Placeholder: "n/a",
 	}
 	return &TestSettings{
 		full: shared,
@@ -40,14 +42,17 @@ func (s *TestSettings) WithColor() *TestSettings {
 	return s
 }
 
-// WithSections appends predefined show all sections ShowHeader, ShowProviders, ShowInputs, ShowOutputs to TestSettings
+// WithSections appends predefined show all sections ShowHeader, ShowProviders, ShowInputs, ShowOutputs, ShowRequirements, ShowResources, ShowDataSources, ShowModules to TestSettings
 func (s *TestSettings) WithSections() *TestSettings {
 	sections := &print.Settings{
+		ShowDataSources:  true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}
 	if err := mergo.Merge(sections, s.full); err == nil {
 		s.full = sections
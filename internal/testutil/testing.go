@@ -18,7 +18,9 @@ func GetModule(options *module.Options) (*tfconf.Module, error) {
 	}
 	options.Path = path
 	if options.OutputValues {
-		options.OutputValuesPath = filepath.Join(path, options.OutputValuesPath)
+		for i, file := range options.OutputValuesPaths {
+			options.OutputValuesPaths[i] = filepath.Join(path, file)
+		}
 	}
 	tfmodule, err := module.LoadWithOptions(options)
 	if err != nil {
@@ -27,6 +29,17 @@ func GetModule(options *module.Options) (*tfconf.Module, error) {
 	return tfmodule, nil
 }
 
+// GetTestModule returns Module of 'name' in 'testdata/modules' of the
+// package under test, for cases 'example' Module doesn't cover
+func GetTestModule(name string, options *module.Options) (*tfconf.Module, error) {
+	options.Path = filepath.Join(testDataPath(), "modules", name)
+	tfmodule, err := module.LoadWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return tfmodule, nil
+}
+
 // GetExpected returns 'example' Module and expected Golden file content
 func GetExpected(format, name string) (string, error) {
 	path := filepath.Join(testDataPath(), format, name+".golden")
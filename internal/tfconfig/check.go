@@ -0,0 +1,21 @@
+package tfconfig
+
+// Check represents a single 'check' block of a Terraform module, which
+// validates the infrastructure with its assertions.
+type Check struct {
+	Name       string            `json:"name"`
+	Assertions []*CheckAssertion `json:"assertions,omitempty"`
+
+	Pos SourcePos `json:"pos"`
+}
+
+// CheckAssertion represents a single 'assert' block of a check.
+type CheckAssertion struct {
+	// Condition is the raw source of the condition expression, as given
+	// in configuration.
+	Condition string `json:"condition"`
+
+	// ErrorMessage is the raw source of the message if it's not a plain
+	// string (e.g. it contains template interpolations).
+	ErrorMessage string `json:"error_message"`
+}
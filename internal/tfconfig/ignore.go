@@ -0,0 +1,84 @@
+package tfconfig
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFileName is the name of the file, in the module directory, listing
+// the patterns of files to skip when the module is loaded
+const IgnoreFileName = ".terraformdocsignore"
+
+// ignorePattern is one pattern of the ignore file, which re-includes the
+// matching files if it's negated with '!'
+type ignorePattern struct {
+	glob   string
+	negate bool
+}
+
+// loadIgnorePatterns reads the patterns of the ignore file of 'dir', in the
+// same syntax as '.gitignore'. It returns no pattern if there's no such file.
+func loadIgnorePatterns(dir string) ([]*ignorePattern, error) {
+	file, err := os.Open(filepath.Join(dir, IgnoreFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	patterns := make([]*ignorePattern, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if p := parseIgnorePattern(scanner.Text()); p != nil {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns, scanner.Err()
+}
+
+// parseIgnorePattern parses one 'line' of the ignore file into a pattern
+// matching the names of files of the module directory, or returns nil if
+// the line is blank, a comment or can only match files of subdirectories.
+func parseIgnorePattern(line string) *ignorePattern {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+	p := &ignorePattern{}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\#`) || strings.HasPrefix(line, `\!`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		// directories aren't loaded at all
+		return nil
+	}
+	line = strings.TrimPrefix(line, "/")
+	for strings.HasPrefix(line, "**/") {
+		line = strings.TrimPrefix(line, "**/")
+	}
+	if strings.Contains(line, "/") || line == "" {
+		return nil
+	}
+	p.glob = strings.Replace(line, "**", "*", -1)
+	return p
+}
+
+// isIgnoredByPatterns returns true if file 'name' is matched by 'patterns',
+// the last matching one of which decides whether it's ignored or not
+func isIgnoredByPatterns(name string, patterns []*ignorePattern) bool {
+	ignored := false
+	for _, p := range patterns {
+		if matched, _ := path.Match(p.glob, name); matched {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
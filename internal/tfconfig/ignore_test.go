@@ -0,0 +1,69 @@
+package tfconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIgnoredByPatterns(t *testing.T) {
+	lines := []string{
+		"# comment",
+		"",
+		"/generated.tf",
+		"**/*_gen.tf",
+		"!keep_gen.tf",
+		"modules/",
+		"nested/main.tf",
+		`\#hash.tf`,
+	}
+	patterns := make([]*ignorePattern, 0)
+	for _, line := range lines {
+		if p := parseIgnorePattern(line); p != nil {
+			patterns = append(patterns, p)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		file     string
+		expected bool
+	}{
+		{
+			name:     "file anchored to module directory",
+			file:     "generated.tf",
+			expected: true,
+		},
+		{
+			name:     "file matched by glob of any directory",
+			file:     "locals_gen.tf",
+			expected: true,
+		},
+		{
+			name:     "file negated by later pattern",
+			file:     "keep_gen.tf",
+			expected: false,
+		},
+		{
+			name:     "file not matched",
+			file:     "variables.tf",
+			expected: false,
+		},
+		{
+			name:     "file of module directory not matched by pattern of subdirectory",
+			file:     "main.tf",
+			expected: false,
+		},
+		{
+			name:     "file with escaped hash",
+			file:     "#hash.tf",
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, isIgnoredByPatterns(tt.file, patterns))
+		})
+	}
+}
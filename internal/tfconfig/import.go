@@ -0,0 +1,15 @@
+package tfconfig
+
+// Import represents a single 'import' block of a Terraform module, which
+// adopts an existing infrastructure object into a resource.
+type Import struct {
+	// To is the raw source of the address of the resource, as given in
+	// configuration (e.g. 'aws_instance.a').
+	To string `json:"to"`
+
+	// ID is the raw source of the id if it's not a plain string (e.g. it
+	// contains template interpolations).
+	ID string `json:"id"`
+
+	Pos SourcePos `json:"pos"`
+}
@@ -68,6 +68,15 @@ func (m *Module) init(diags Diagnostics) {
 	m.Diagnostics = diags
 }
 
+// isOverrideFile returns true if 'name' is an override file (e.g.
+// 'override.tf' or 'main_override.tf'), whose blocks replace the ones of
+// the same name declared in primary files.
+func isOverrideFile(name string) bool {
+	ext := fileExt(name)
+	baseName := name[:len(name)-len(ext)] // strip extension
+	return baseName == "override" || strings.HasSuffix(baseName, "_override")
+}
+
 func dirFiles(dir string) (primary []string, diags hcl.Diagnostics) {
 	infos, err := ioutil.ReadDir(dir)
 	if err != nil {
@@ -79,6 +88,16 @@ func dirFiles(dir string) (primary []string, diags hcl.Diagnostics) {
 		return
 	}
 
+	patterns, err := loadIgnorePatterns(dir)
+	if err != nil {
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Failed to read ignore file",
+			Detail:   fmt.Sprintf("Ignore file %s of module directory %s cannot be read: %s.", IgnoreFileName, dir, err),
+		})
+		return
+	}
+
 	var override []string
 	for _, info := range infos {
 		if info.IsDir() {
@@ -88,15 +107,12 @@ func dirFiles(dir string) (primary []string, diags hcl.Diagnostics) {
 
 		name := info.Name()
 		ext := fileExt(name)
-		if ext == "" || isIgnoredFile(name) {
+		if ext == "" || isIgnoredFile(name) || isIgnoredByPatterns(name, patterns) {
 			continue
 		}
 
-		baseName := name[:len(name)-len(ext)] // strip extension
-		isOverride := baseName == "override" || strings.HasSuffix(baseName, "_override")
-
 		fullPath := filepath.Join(dir, name)
-		if isOverride {
+		if isOverrideFile(name) {
 			override = append(override, fullPath)
 		} else {
 			primary = append(primary, fullPath)
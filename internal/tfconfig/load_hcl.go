@@ -3,6 +3,7 @@ package tfconfig
 import (
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/hashicorp/hcl/v2/hclsyntax"
@@ -18,8 +19,10 @@ func loadModule(dir string) (*Module, Diagnostics) {
 	primaryPaths, diags := dirFiles(dir)
 
 	parser := hclparse.NewParser()
+	providerConfigs := make(map[string]SourcePos)
 
 	for _, filename := range primaryPaths {
+		override := isOverrideFile(filepath.Base(filename))
 		var file *hcl.File
 		var fileDiags hcl.Diagnostics
 		if strings.HasSuffix(filename, ".json") {
@@ -48,6 +51,7 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					diags = append(diags, valDiags...)
 					if !valDiags.HasErrors() {
 						mod.RequiredCore = append(mod.RequiredCore, version)
+						mod.RequiredCorePos = append(mod.RequiredCorePos, sourcePosHCL(attr.Range))
 					}
 				}
 
@@ -61,6 +65,9 @@ func loadModule(dir string) (*Module, Diagnostics) {
 								mod.RequiredProviders[name] = req
 							} else {
 								mod.RequiredProviders[name].VersionConstraints = append(mod.RequiredProviders[name].VersionConstraints, req.VersionConstraints...)
+								mod.RequiredProviders[name].VersionPos = append(mod.RequiredProviders[name].VersionPos, req.VersionPos...)
+								mod.RequiredProviders[name].ConfigurationAliases = append(mod.RequiredProviders[name].ConfigurationAliases, req.ConfigurationAliases...)
+								mod.RequiredProviders[name].ConfigurationAliasPos = append(mod.RequiredProviders[name].ConfigurationAliasPos, req.ConfigurationAliasPos...)
 							}
 						}
 					}
@@ -71,6 +78,10 @@ func loadModule(dir string) (*Module, Diagnostics) {
 				diags = append(diags, contentDiags...)
 
 				name := block.Labels[0]
+				if prev, exists := mod.Variables[name]; exists && !override {
+					diags = append(diags, duplicateDiagnostic("variable", name, prev.Pos, block.DefRange))
+					continue
+				}
 				v := &Variable{
 					Name: name,
 					Pos:  sourcePosHCL(block.DefRange),
@@ -153,12 +164,55 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					v.Required = true
 				}
 
+				if attr, defined := content.Attributes["sensitive"]; defined {
+					var sensitive bool
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &sensitive)
+					diags = append(diags, valDiags...)
+					v.Sensitive = sensitive
+				}
+
+				if attr, defined := content.Attributes["nullable"]; defined {
+					var nullable bool
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &nullable)
+					diags = append(diags, valDiags...)
+					v.Nullable = &nullable
+				}
+
+				for _, innerBlock := range content.Blocks {
+					if innerBlock.Type != "validation" {
+						continue
+					}
+					content, _, contentDiags := innerBlock.Body.PartialContent(variableValidationSchema)
+					diags = append(diags, contentDiags...)
+
+					// Same as 'type', the condition is an expression which may
+					// refer to 'var', so we only take the raw source of it.
+					validation := &VariableValidation{}
+					if attr, defined := content.Attributes["condition"]; defined {
+						validation.Condition = exprSource(parser, attr.Expr)
+					}
+					if attr, defined := content.Attributes["error_message"]; defined {
+						var message string
+						valDiags := gohcl.DecodeExpression(attr.Expr, nil, &message)
+						if !valDiags.HasErrors() {
+							validation.ErrorMessage = message
+						} else {
+							validation.ErrorMessage = unquote(exprSource(parser, attr.Expr)) // template
+						}
+					}
+					v.Validations = append(v.Validations, validation)
+				}
+
 			case "output":
 
 				content, _, contentDiags := block.Body.PartialContent(outputSchema)
 				diags = append(diags, contentDiags...)
 
 				name := block.Labels[0]
+				if prev, exists := mod.Outputs[name]; exists && !override {
+					diags = append(diags, duplicateDiagnostic("output", name, prev.Pos, block.DefRange))
+					continue
+				}
 				o := &Output{
 					Name: name,
 					Pos:  sourcePosHCL(block.DefRange),
@@ -173,12 +227,32 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					o.Description = description
 				}
 
+				if attr, defined := content.Attributes["sensitive"]; defined {
+					var sensitive bool
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &sensitive)
+					diags = append(diags, valDiags...)
+					o.Sensitive = sensitive
+				}
+
 			case "provider":
 
 				content, _, contentDiags := block.Body.PartialContent(providerConfigSchema)
 				diags = append(diags, contentDiags...)
 
 				name := block.Labels[0]
+				key := name
+				if attr, defined := content.Attributes["alias"]; defined {
+					var alias string
+					if valDiags := gohcl.DecodeExpression(attr.Expr, nil, &alias); !valDiags.HasErrors() {
+						key = name + "." + alias
+					}
+				}
+				if prev, exists := providerConfigs[key]; exists && !override {
+					diags = append(diags, duplicateDiagnostic("provider configuration", key, prev, block.DefRange))
+					continue
+				}
+				providerConfigs[key] = sourcePosHCL(block.DefRange)
+
 				// Even if there isn't an explicit version required, we still
 				// need an entry in our map to signal the unversioned dependency.
 				if _, exists := mod.RequiredProviders[name]; !exists {
@@ -190,6 +264,7 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					diags = append(diags, valDiags...)
 					if !valDiags.HasErrors() {
 						mod.RequiredProviders[name].VersionConstraints = append(mod.RequiredProviders[name].VersionConstraints, version)
+						mod.RequiredProviders[name].VersionPos = append(mod.RequiredProviders[name].VersionPos, sourcePosHCL(attr.Range))
 					}
 				}
 
@@ -309,6 +384,92 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					mc.Version = version
 				}
 
+				// addresses are references rather than values, so we only
+				// take the raw source of each one of them
+				if attr, defined := content.Attributes["depends_on"]; defined {
+					exprs, listDiags := hcl.ExprList(attr.Expr)
+					diags = append(diags, listDiags...)
+					for _, expr := range exprs {
+						mc.DependsOn = append(mc.DependsOn, unquote(exprSource(parser, expr)))
+					}
+				}
+
+			case "moved":
+
+				content, _, contentDiags := block.Body.PartialContent(movedSchema)
+				diags = append(diags, contentDiags...)
+
+				// Addresses are references rather than values, so we only take
+				// the raw source of them, same as 'type' of variables.
+				m := &Moved{
+					Pos: sourcePosHCL(block.DefRange),
+				}
+				if attr, defined := content.Attributes["from"]; defined {
+					m.From = unquote(exprSource(parser, attr.Expr))
+				}
+				if attr, defined := content.Attributes["to"]; defined {
+					m.To = unquote(exprSource(parser, attr.Expr))
+				}
+
+				mod.Moved = append(mod.Moved, m)
+
+			case "check":
+
+				content, _, contentDiags := block.Body.PartialContent(checkSchema)
+				diags = append(diags, contentDiags...)
+
+				c := &Check{
+					Name: block.Labels[0],
+					Pos:  sourcePosHCL(block.DefRange),
+				}
+
+				// Scoped 'data' blocks of the check are skipped, only its
+				// assertions are taken, the same way as validation rules.
+				for _, innerBlock := range content.Blocks {
+					content, _, contentDiags := innerBlock.Body.PartialContent(checkAssertSchema)
+					diags = append(diags, contentDiags...)
+
+					assertion := &CheckAssertion{}
+					if attr, defined := content.Attributes["condition"]; defined {
+						assertion.Condition = exprSource(parser, attr.Expr)
+					}
+					if attr, defined := content.Attributes["error_message"]; defined {
+						var message string
+						valDiags := gohcl.DecodeExpression(attr.Expr, nil, &message)
+						if !valDiags.HasErrors() {
+							assertion.ErrorMessage = message
+						} else {
+							assertion.ErrorMessage = unquote(exprSource(parser, attr.Expr)) // template
+						}
+					}
+					c.Assertions = append(c.Assertions, assertion)
+				}
+
+				mod.Checks = append(mod.Checks, c)
+
+			case "import":
+
+				content, _, contentDiags := block.Body.PartialContent(importSchema)
+				diags = append(diags, contentDiags...)
+
+				i := &Import{
+					Pos: sourcePosHCL(block.DefRange),
+				}
+				if attr, defined := content.Attributes["to"]; defined {
+					i.To = unquote(exprSource(parser, attr.Expr))
+				}
+				if attr, defined := content.Attributes["id"]; defined {
+					var id string
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &id)
+					if !valDiags.HasErrors() {
+						i.ID = id
+					} else {
+						i.ID = unquote(exprSource(parser, attr.Expr)) // template
+					}
+				}
+
+				mod.Imports = append(mod.Imports, i)
+
 			default:
 				// Should never happen because our cases above should be
 				// exhaustive for our schema.
@@ -319,3 +480,35 @@ func loadModule(dir string) (*Module, Diagnostics) {
 
 	return mod, diagnosticsHCL(diags)
 }
+
+// exprSource returns the raw source of 'expr', as given in configuration, or
+// an empty string if the source of its file isn't available.
+func exprSource(parser *hclparse.Parser, expr hcl.Expression) string {
+	rng := expr.Range()
+	source, exists := parser.Sources()[rng.Filename]
+	if !exists {
+		return ""
+	}
+	return string(rng.SliceBytes(source))
+}
+
+// unquote returns 's' without its surrounding double quotes, if any (e.g. the
+// raw source of a string template, or an address in JSON syntax).
+func unquote(s string) string {
+	if len(s) > 1 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+// duplicateDiagnostic returns a warning about the block of 'kind' named
+// 'name' at 'rng', which is already declared at 'prev'. Unlike Terraform,
+// which refuses such configuration, the first declaration is kept.
+func duplicateDiagnostic(kind string, name string, prev SourcePos, rng hcl.Range) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagWarning,
+		Summary:  fmt.Sprintf("Duplicate %s declaration", kind),
+		Detail:   fmt.Sprintf("The %s %q was already declared at %s:%d. Names must be unique within a module, this declaration is ignored.", kind, name, prev.Filename, prev.Line),
+		Subject:  &rng,
+	}
+}
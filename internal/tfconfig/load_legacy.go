@@ -38,6 +38,7 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 		}
 
 		for _, item := range list.Filter("terraform").Items {
+			pos := item.Pos()
 			if len(item.Keys) > 0 {
 				item = &legacyast.ObjectItem{
 					Val: &legacyast.ObjectType{
@@ -59,6 +60,7 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 
 			if block.RequiredVersion != "" {
 				mod.RequiredCore = append(mod.RequiredCore, block.RequiredVersion)
+				mod.RequiredCorePos = append(mod.RequiredCorePos, sourcePosLegacyHCL(pos, filename))
 			}
 		}
 
@@ -117,6 +119,7 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 			outputs = outputs.Children()
 			type OutputBlock struct {
 				Description string
+				Sensitive   bool
 			}
 
 			for _, item := range outputs.Items {
@@ -137,6 +140,7 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 				o := &Output{
 					Name:        name,
 					Description: block.Description,
+					Sensitive:   block.Sensitive,
 					Pos:         sourcePosLegacyHCL(item.Pos(), filename),
 				}
 				if _, exists := mod.Outputs[name]; exists {
@@ -276,6 +280,7 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 
 				if block.Version != "" {
 					mod.RequiredProviders[name].VersionConstraints = append(mod.RequiredProviders[name].VersionConstraints, block.Version)
+					mod.RequiredProviders[name].VersionPos = append(mod.RequiredProviders[name].VersionPos, sourcePosLegacyHCL(item.Pos(), filename))
 				}
 			}
 		}
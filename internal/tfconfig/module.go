@@ -10,12 +10,22 @@ type Module struct {
 	Outputs   map[string]*Output   `json:"outputs"`
 
 	RequiredCore      []string                        `json:"required_core,omitempty"`
+	RequiredCorePos   []SourcePos                     `json:"-"` // position of each item of RequiredCore
 	RequiredProviders map[string]*ProviderRequirement `json:"required_providers"`
 
 	ManagedResources map[string]*Resource   `json:"managed_resources"`
 	DataResources    map[string]*Resource   `json:"data_resources"`
 	ModuleCalls      map[string]*ModuleCall `json:"module_calls"`
 
+	// Moved lists 'moved' blocks of the module in the order of declaration.
+	Moved []*Moved `json:"moved,omitempty"`
+
+	// Checks lists 'check' blocks of the module in the order of declaration.
+	Checks []*Check `json:"checks,omitempty"`
+
+	// Imports lists 'import' blocks of the module in the order of declaration.
+	Imports []*Import `json:"imports,omitempty"`
+
 	// Diagnostics records any errors and warnings that were detected during
 	// loading, primarily for inclusion in serialized forms of the module
 	// since this slice is also returned as a second argument from LoadModule.
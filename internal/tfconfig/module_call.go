@@ -7,5 +7,9 @@ type ModuleCall struct {
 	Source  string `json:"source"`
 	Version string `json:"version,omitempty"`
 
+	// DependsOn are the addresses of 'depends_on' meta-argument, as given
+	// in configuration (e.g. "module.network")
+	DependsOn []string `json:"depends_on,omitempty"`
+
 	Pos SourcePos `json:"pos"`
 }
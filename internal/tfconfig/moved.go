@@ -0,0 +1,12 @@
+package tfconfig
+
+// Moved represents a single 'moved' block of a Terraform module, which
+// records the previous address of a resource or module call.
+type Moved struct {
+	// From and To are the raw source of the addresses, as given in
+	// configuration (e.g. 'aws_instance.a').
+	From string `json:"from"`
+	To   string `json:"to"`
+
+	Pos SourcePos `json:"pos"`
+}
@@ -4,6 +4,7 @@ package tfconfig
 type Output struct {
 	Name        string `json:"name"`
 	Description string `json:"description,omitempty"`
+	Sensitive   bool   `json:"sensitive,omitempty"`
 
 	Pos SourcePos `json:"pos"`
 }
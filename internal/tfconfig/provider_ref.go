@@ -1,9 +1,11 @@
 package tfconfig
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
-	"github.com/zclconf/go-cty/cty/gocty"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // ProviderRef is a reference to a provider configuration within a module.
@@ -15,37 +17,61 @@ type ProviderRef struct {
 }
 
 type ProviderRequirement struct {
-	Source             string   `json:"source,omitempty"`
-	VersionConstraints []string `json:"version_constraints,omitempty"`
+	Source             string      `json:"source,omitempty"`
+	VersionConstraints []string    `json:"version_constraints,omitempty"`
+	VersionPos         []SourcePos `json:"-"` // position of each item of VersionConstraints
+
+	ConfigurationAliases  []ProviderRef `json:"configuration_aliases,omitempty"`
+	ConfigurationAliasPos []SourcePos   `json:"-"` // position of each item of ConfigurationAliases
 }
 
 func decodeRequiredProvidersBlock(block *hcl.Block) (map[string]*ProviderRequirement, hcl.Diagnostics) {
 	attrs, diags := block.Body.JustAttributes()
 	reqs := make(map[string]*ProviderRequirement)
 	for name, attr := range attrs {
-		expr, err := attr.Expr.Value(nil)
-		if err != nil {
-			diags = append(diags, err...)
-		}
-
-		switch {
-		case expr.Type().IsPrimitiveType():
+		// Look for a single static string, in case we have the legacy
+		// version-only format in the configuration.
+		if expr, err := attr.Expr.Value(nil); err == nil && expr.Type().IsPrimitiveType() {
 			var version string
 			valDiags := gohcl.DecodeExpression(attr.Expr, nil, &version)
 			diags = append(diags, valDiags...)
 			if !valDiags.HasErrors() {
 				reqs[name] = &ProviderRequirement{
 					VersionConstraints: []string{version},
+					VersionPos:         []SourcePos{sourcePosHCL(attr.Range)},
 				}
 			}
+			continue
+		}
+
+		// The object can't be evaluated as a whole, since the items of
+		// 'configuration_aliases' are references to provider configurations
+		// (e.g. aws.east) rather than values.
+		kvs, mapDiags := hcl.ExprMap(attr.Expr)
+		if mapDiags.HasErrors() {
+			diags = append(diags, &hcl.Diagnostic{
+				Severity: hcl.DiagError,
+				Summary:  "Unsuitable value type",
+				Detail:   "Unsuitable value: string required",
+				Subject:  attr.Expr.Range().Ptr(),
+			})
+			continue
+		}
 
-		case expr.Type().IsObjectType():
-			var pr ProviderRequirement
-			if expr.Type().HasAttribute("version") {
+		var pr ProviderRequirement
+		for _, kv := range kvs {
+			key, keyDiags := kv.Key.Value(nil)
+			if keyDiags.HasErrors() || key.Type() != cty.String || key.IsNull() {
+				diags = append(diags, keyDiags...)
+				continue
+			}
+			switch key.AsString() {
+			case "version":
 				var version string
-				err := gocty.FromCtyValue(expr.GetAttr("version"), &version)
-				if err == nil {
+				valDiags := gohcl.DecodeExpression(kv.Value, nil, &version)
+				if !valDiags.HasErrors() {
 					pr.VersionConstraints = append(pr.VersionConstraints, version)
+					pr.VersionPos = append(pr.VersionPos, sourcePosHCL(attr.Range))
 				} else {
 					diags = append(diags, &hcl.Diagnostic{
 						Severity: hcl.DiagError,
@@ -54,11 +80,10 @@ func decodeRequiredProvidersBlock(block *hcl.Block) (map[string]*ProviderRequire
 						Subject:  attr.Expr.Range().Ptr(),
 					})
 				}
-			}
-			if expr.Type().HasAttribute("source") {
+			case "source":
 				var source string
-				err := gocty.FromCtyValue(expr.GetAttr("source"), &source)
-				if err == nil {
+				valDiags := gohcl.DecodeExpression(kv.Value, nil, &source)
+				if !valDiags.HasErrors() {
 					pr.Source = source
 				} else {
 					diags = append(diags, &hcl.Diagnostic{
@@ -68,17 +93,36 @@ func decodeRequiredProvidersBlock(block *hcl.Block) (map[string]*ProviderRequire
 						Subject:  attr.Expr.Range().Ptr(),
 					})
 				}
+			case "configuration_aliases":
+				exprs, listDiags := hcl.ExprList(kv.Value)
+				if listDiags.HasErrors() {
+					diags = append(diags, listDiags...)
+					continue
+				}
+				for _, expr := range exprs {
+					traversal, travDiags := hcl.AbsTraversalForExpr(expr)
+					if travDiags.HasErrors() || len(traversal) != 2 || traversal.RootName() != name {
+						diags = append(diags, &hcl.Diagnostic{
+							Severity: hcl.DiagError,
+							Summary:  "Invalid configuration alias",
+							Detail:   fmt.Sprintf("Configuration alias requires the provider name followed by an alias, like \"%s.foo\".", name),
+							Subject:  expr.Range().Ptr(),
+						})
+						continue
+					}
+					getAttr, ok := traversal[1].(hcl.TraverseAttr)
+					if !ok {
+						continue
+					}
+					pr.ConfigurationAliases = append(pr.ConfigurationAliases, ProviderRef{
+						Name:  name,
+						Alias: getAttr.Name,
+					})
+					pr.ConfigurationAliasPos = append(pr.ConfigurationAliasPos, sourcePosHCL(expr.Range()))
+				}
 			}
-			reqs[name] = &pr
-
-		default:
-			diags = append(diags, &hcl.Diagnostic{
-				Severity: hcl.DiagError,
-				Summary:  "Unsuitable value type",
-				Detail:   "Unsuitable value: string required",
-				Subject:  attr.Expr.Range().Ptr(),
-			})
 		}
+		reqs[name] = &pr
 	}
 
 	return reqs, diags
@@ -34,6 +34,18 @@ var rootSchema = &hcl.BodySchema{
 			Type:       "module",
 			LabelNames: []string{"name"},
 		},
+		{
+			Type:       "moved",
+			LabelNames: nil,
+		},
+		{
+			Type:       "check",
+			LabelNames: []string{"name"},
+		},
+		{
+			Type:       "import",
+			LabelNames: nil,
+		},
 	},
 }
 
@@ -72,6 +84,69 @@ var variableSchema = &hcl.BodySchema{
 		{
 			Name: "default",
 		},
+		{
+			Name: "sensitive",
+		},
+		{
+			Name: "nullable",
+		},
+	},
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type: "validation",
+		},
+	},
+}
+
+var variableValidationSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name: "condition",
+		},
+		{
+			Name: "error_message",
+		},
+	},
+}
+
+var movedSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name: "from",
+		},
+		{
+			Name: "to",
+		},
+	},
+}
+
+var importSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name: "to",
+		},
+		{
+			Name: "id",
+		},
+	},
+}
+
+var checkSchema = &hcl.BodySchema{
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type: "assert",
+		},
+	},
+}
+
+var checkAssertSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name: "condition",
+		},
+		{
+			Name: "error_message",
+		},
 	},
 }
 
@@ -80,6 +155,9 @@ var outputSchema = &hcl.BodySchema{
 		{
 			Name: "description",
 		},
+		{
+			Name: "sensitive",
+		},
 	},
 }
 
@@ -94,6 +172,9 @@ var moduleCallSchema = &hcl.BodySchema{
 		{
 			Name: "providers",
 		},
+		{
+			Name: "depends_on",
+		},
 	},
 }
 
@@ -0,0 +1,22 @@
+check "health" {
+  data "http" "endpoint" {
+    url = "https://example.com/health"
+  }
+
+  assert {
+    condition     = data.http.endpoint.status_code == 200
+    error_message = "Endpoint returned ${data.http.endpoint.status_code}."
+  }
+}
+
+check "certificate" {
+  assert {
+    condition     = length(var.domains) > 0
+    error_message = "At least one domain is required."
+  }
+
+  assert {
+    condition     = var.days_left > 30
+    error_message = "Certificate expires soon."
+  }
+}
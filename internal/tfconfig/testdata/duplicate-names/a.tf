@@ -0,0 +1,12 @@
+variable "foo" {
+  description = "The first foo"
+}
+
+output "bar" {
+  description = "The first bar"
+  value       = "bar"
+}
+
+provider "null" {
+  alias = "east"
+}
@@ -0,0 +1,16 @@
+variable "foo" {
+  description = "The second foo"
+}
+
+output "bar" {
+  description = "The second bar"
+  value       = "bar"
+}
+
+provider "null" {
+  alias = "east"
+}
+
+provider "null" {
+  alias = "west"
+}
@@ -0,0 +1,3 @@
+variable "generated" {
+  description = "Generated input, ignored."
+}
@@ -0,0 +1,4 @@
+output "generated" {
+  description = "Generated output, included back."
+  value       = "${var.name}"
+}
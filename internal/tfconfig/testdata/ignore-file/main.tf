@@ -0,0 +1,7 @@
+variable "name" {
+  description = "Name of the resources."
+}
+
+output "id" {
+  value = "${var.name}"
+}
@@ -0,0 +1,12 @@
+resource "aws_instance" "web" {
+}
+
+import {
+  to = aws_instance.web
+  id = "i-0123456789abcdef0"
+}
+
+import {
+  to = aws_s3_bucket.logs
+  id = "${var.prefix}-logs"
+}
@@ -9,4 +9,6 @@ module "bar" {
   source = "./child"
 
   unused = 1
+
+  depends_on = [module.foo, aws_s3_bucket.logs]
 }
@@ -0,0 +1,16 @@
+resource "aws_instance" "b" {
+}
+
+module "network" {
+  source = "./network"
+}
+
+moved {
+  from = aws_instance.a
+  to   = aws_instance.b
+}
+
+moved {
+  from = module.vpc
+  to   = module.network
+}
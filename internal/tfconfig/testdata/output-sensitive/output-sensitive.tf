@@ -0,0 +1,9 @@
+output "password" {
+  value     = "secret"
+  sensitive = true
+}
+
+output "username" {
+  value     = "admin"
+  sensitive = false
+}
@@ -0,0 +1,13 @@
+variable "name" {
+  type     = string
+  nullable = false
+}
+
+variable "tags" {
+  type     = map(string)
+  nullable = true
+}
+
+variable "zone" {
+  type = string
+}
@@ -0,0 +1,9 @@
+variable "password" {
+  type      = string
+  sensitive = true
+}
+
+variable "username" {
+  type      = string
+  sensitive = false
+}
@@ -40,3 +40,11 @@ variable "bool_default_false" {
   type    = bool
   default = false
 }
+
+variable "nested_object" {
+  type = list(object({
+    name = string # inline comment
+    tags = optional(map(string), {})
+    rules = list(object({ port = number, cidrs = list(string) }))
+  }))
+}
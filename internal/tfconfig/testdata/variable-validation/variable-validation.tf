@@ -0,0 +1,17 @@
+variable "name" {
+  type = string
+
+  validation {
+    condition     = length(var.name) > 0
+    error_message = "The name must not be empty."
+  }
+
+  validation {
+    condition     = can(regex("^[a-z]+$", var.name))
+    error_message = "The name must only contain lowercase letters, got ${var.name}."
+  }
+}
+
+variable "zone" {
+  type = string
+}
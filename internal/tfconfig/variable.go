@@ -10,8 +10,26 @@ type Variable struct {
 	// the native Go type system. The conversion from the value given in
 	// configuration may be slightly lossy. Only values that can be
 	// serialized by json.Marshal will be included here.
-	Default  interface{} `json:"default"`
-	Required bool        `json:"required"`
+	Default   interface{} `json:"default"`
+	Required  bool        `json:"required"`
+	Sensitive bool        `json:"sensitive,omitempty"`
+
+	// Nullable is nil if the variable doesn't set 'nullable' explicitly,
+	// in which case it's nullable, same as Terraform.
+	Nullable *bool `json:"nullable,omitempty"`
+
+	Validations []*VariableValidation `json:"validations,omitempty"`
 
 	Pos SourcePos `json:"pos"`
 }
+
+// VariableValidation represents a single 'validation' block of a variable.
+type VariableValidation struct {
+	// Condition is the raw source of the condition expression, as given
+	// in configuration.
+	Condition string `json:"condition"`
+
+	// ErrorMessage is the raw source of the message if it's not a plain
+	// string (e.g. it contains template interpolations).
+	ErrorMessage string `json:"error_message"`
+}
@@ -0,0 +1,263 @@
+package types
+
+import (
+	"strings"
+	"unicode"
+)
+
+// typeNode is a node of a parsed Terraform type expression, which is either
+// a keyword or literal (e.g. 'string'), a call (e.g. 'list(string)'), an
+// object (e.g. '{ name = string }') or a tuple (e.g. '[string, number]').
+type typeNode struct {
+	kind  byte // 'a' atom, '(' call, '{' object, '[' tuple
+	value string
+	keys  []string
+	items []*typeNode
+}
+
+// IsComplexType indicates if the type expression 't' is a complex type
+// (e.g. 'object({...})', 'list(string)') and not a primitive one
+func IsComplexType(t string) bool {
+	return strings.ContainsAny(t, "({[")
+}
+
+// FormatComplexType reformats the type expression 't' with one attribute
+// of objects per line and indentation of nested types, the same way they
+// are written by 'terraform fmt'. The expression is returned unchanged if
+// it can't be parsed (e.g. it contains comments).
+func FormatComplexType(t string) string {
+	p := &typeParser{tokens: tokenizeType(t)}
+	if p.tokens == nil {
+		return t
+	}
+	node, ok := p.parse()
+	if !ok || p.pos != len(p.tokens) {
+		return t
+	}
+	return node.render("")
+}
+
+// NormalizeType rewrites the type expression 't' in a canonical form on one
+// line, with consistent spacing (e.g. 'object({ name = string })') and the
+// quotes of legacy types (e.g. '"string"') and of object attributes removed,
+// so the same type reads the same regardless of how it's written. The
+// expression is returned unchanged if it can't be parsed.
+func NormalizeType(t string) string {
+	p := &typeParser{tokens: tokenizeType(t)}
+	if p.tokens == nil {
+		return t
+	}
+	node, ok := p.parse()
+	if !ok || p.pos != len(p.tokens) {
+		return t
+	}
+	return node.inline(true)
+}
+
+// inline renders the node on one line, 'typed' indicates the node is a type
+// and not the default value of an optional attribute.
+func (n *typeNode) inline(typed bool) string {
+	switch n.kind {
+	case '(':
+		items := make([]string, 0, len(n.items))
+		for i, item := range n.items {
+			items = append(items, item.inline(typed && (n.value != "optional" || i == 0)))
+		}
+		return n.value + "(" + strings.Join(items, ", ") + ")"
+	case '{':
+		if len(n.items) == 0 {
+			return "{}"
+		}
+		items := make([]string, 0, len(n.items))
+		for i, key := range n.keys {
+			items = append(items, unquoteKey(key)+" = "+n.items[i].inline(typed))
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	case '[':
+		items := make([]string, 0, len(n.items))
+		for _, item := range n.items {
+			items = append(items, item.inline(typed))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	if typed {
+		switch n.value {
+		case `"string"`, `"number"`, `"bool"`, `"list"`, `"map"`, `"set"`, `"any"`:
+			return strings.Trim(n.value, `"`)
+		}
+	}
+	return n.value
+}
+
+// unquoteKey removes quotes of the object attribute 'key' if it's a valid
+// identifier without them
+func unquoteKey(key string) string {
+	unquoted := strings.Trim(key, `"`)
+	if len(unquoted) != len(key)-2 || unquoted == "" || unicode.IsDigit([]rune(unquoted)[0]) {
+		return key
+	}
+	for _, r := range unquoted {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			return key
+		}
+	}
+	return unquoted
+}
+
+func (n *typeNode) render(indent string) string {
+	switch n.kind {
+	case '(':
+		items := make([]string, 0, len(n.items))
+		for _, item := range n.items {
+			items = append(items, item.render(indent))
+		}
+		return n.value + "(" + strings.Join(items, ", ") + ")"
+	case '{':
+		if len(n.items) == 0 {
+			return "{}"
+		}
+		width := 0
+		for _, key := range n.keys {
+			if len(key) > width {
+				width = len(key)
+			}
+		}
+		var b strings.Builder
+		b.WriteString("{\n")
+		for i, key := range n.keys {
+			b.WriteString(indent + "  " + key + strings.Repeat(" ", width-len(key)) + " = ")
+			b.WriteString(n.items[i].render(indent + "  "))
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + "}")
+		return b.String()
+	case '[':
+		items := make([]string, 0, len(n.items))
+		for _, item := range n.items {
+			items = append(items, item.render(indent))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	return n.value
+}
+
+type typeParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *typeParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+func (p *typeParser) next() string {
+	token := p.peek()
+	p.pos++
+	return token
+}
+
+func (p *typeParser) parse() (*typeNode, bool) {
+	token := p.next()
+	switch token {
+	case "":
+		return nil, false
+	case "{":
+		node := &typeNode{kind: '{'}
+		for p.peek() != "}" {
+			key := p.next()
+			if key == "" || strings.ContainsAny(key, "{}[](),=:") {
+				return nil, false
+			}
+			if sep := p.next(); sep != "=" && sep != ":" {
+				return nil, false
+			}
+			item, ok := p.parse()
+			if !ok {
+				return nil, false
+			}
+			node.keys = append(node.keys, key)
+			node.items = append(node.items, item)
+			if p.peek() == "," {
+				p.next()
+			}
+		}
+		p.next()
+		return node, true
+	case "[":
+		node := &typeNode{kind: '['}
+		items, ok := p.list("]")
+		node.items = items
+		return node, ok
+	case "}", "]", "(", ")", ",", "=", ":":
+		return nil, false
+	}
+	if p.peek() == "(" {
+		p.next()
+		node := &typeNode{kind: '(', value: token}
+		items, ok := p.list(")")
+		node.items = items
+		return node, ok
+	}
+	return &typeNode{kind: 'a', value: token}, true
+}
+
+// list parses comma separated items until the closing 'end' token
+func (p *typeParser) list(end string) ([]*typeNode, bool) {
+	items := make([]*typeNode, 0)
+	for p.peek() != end {
+		item, ok := p.parse()
+		if !ok {
+			return nil, false
+		}
+		items = append(items, item)
+		if p.peek() == "," {
+			p.next()
+		} else if p.peek() != end {
+			return nil, false
+		}
+	}
+	p.next()
+	return items, true
+}
+
+// tokenizeType splits the type expression 't' into its tokens, or returns
+// nil if it contains anything not expected in a type expression
+func tokenizeType(t string) []string {
+	tokens := make([]string, 0)
+	runes := []rune(t)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case strings.ContainsRune("{}[](),=:", r):
+			tokens = append(tokens, string(r))
+			i++
+		case r == '"':
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(runes) {
+				return nil
+			}
+			tokens = append(tokens, string(runes[i:j+1]))
+			i = j + 1
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '.':
+			j := i + 1
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || strings.ContainsRune("_-.", runes[j])) {
+				j++
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			return nil // e.g. comments
+		}
+	}
+	return tokens
+}
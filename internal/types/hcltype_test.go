@@ -0,0 +1,157 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsComplexType(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        string
+		expected bool
+	}{
+		{
+			name:     "primitive type",
+			t:        "string",
+			expected: false,
+		},
+		{
+			name:     "type inferred from default value",
+			t:        "map",
+			expected: false,
+		},
+		{
+			name:     "collection type",
+			t:        "list(string)",
+			expected: true,
+		},
+		{
+			name:     "object type",
+			t:        "object({ name = string })",
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, IsComplexType(tt.t))
+		})
+	}
+}
+
+func TestFormatComplexType(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        string
+		expected string
+	}{
+		{
+			name:     "collection type",
+			t:        "list( string )",
+			expected: "list(string)",
+		},
+		{
+			name:     "empty object type",
+			t:        "object({})",
+			expected: "object({})",
+		},
+		{
+			name:     "single line object type",
+			t:        "object({ name = string, enabled = bool })",
+			expected: "object({\n  name    = string\n  enabled = bool\n})",
+		},
+		{
+			name:     "nested object types",
+			t:        "object({\n    name = string,\n    foo  = object({ foo = string, bar = string }),\n    fizz = list(string)\n  })",
+			expected: "object({\n  name = string\n  foo  = object({\n    foo = string\n    bar = string\n  })\n  fizz = list(string)\n})",
+		},
+		{
+			name:     "collection of objects",
+			t:        "map(object({ id = number }))",
+			expected: "map(object({\n  id = number\n}))",
+		},
+		{
+			name:     "tuple type",
+			t:        "tuple([string,number])",
+			expected: "tuple([string, number])",
+		},
+		{
+			name:     "optional attribute with default value",
+			t:        `object({ port = optional(number, 80), tag = optional(string, "a,b") })`,
+			expected: "object({\n  port = optional(number, 80)\n  tag  = optional(string, \"a,b\")\n})",
+		},
+		{
+			name:     "type with comments unchanged",
+			t:        "object({\n  name = string # the name\n})",
+			expected: "object({\n  name = string # the name\n})",
+		},
+		{
+			name:     "invalid type unchanged",
+			t:        "object({ name = })",
+			expected: "object({ name = })",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, FormatComplexType(tt.t))
+		})
+	}
+}
+
+func TestNormalizeType(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        string
+		expected string
+	}{
+		{
+			name:     "primitive type",
+			t:        "string",
+			expected: "string",
+		},
+		{
+			name:     "legacy quoted type",
+			t:        `"string"`,
+			expected: "string",
+		},
+		{
+			name:     "collection type",
+			t:        "map( list(\"string\") )",
+			expected: "map(list(string))",
+		},
+		{
+			name:     "object type",
+			t:        "object({\n  name    = string,\n  \"tags\" = map(string)\n})",
+			expected: "object({ name = string, tags = map(string) })",
+		},
+		{
+			name:     "empty object type",
+			t:        "object({ })",
+			expected: "object({})",
+		},
+		{
+			name:     "tuple type",
+			t:        "tuple([ string,number ])",
+			expected: "tuple([string, number])",
+		},
+		{
+			name:     "optional attribute with default",
+			t:        `object({ kind = optional("string", "string") })`,
+			expected: `object({ kind = optional(string, "string") })`,
+		},
+		{
+			name:     "invalid type unchanged",
+			t:        "object({ name = })",
+			expected: "object({ name = })",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, NormalizeType(tt.t))
+		})
+	}
+}
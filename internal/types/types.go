@@ -6,6 +6,7 @@ import (
 	"go/types"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -75,6 +76,65 @@ func TypeOf(t string, v interface{}) String {
 	return String("any")
 }
 
+// Coerce converts value 'v' into the declared Terraform type 't', the same
+// way Terraform converts the default value of a variable to its type (e.g.
+// `default = "5"` of a 'number' variable is 5). Elements of collections of
+// primitive types (e.g. 'list(number)') are converted too. The value is
+// returned unchanged if it can't be converted.
+func Coerce(t string, v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	t = strings.TrimSpace(t)
+	switch t {
+	case "string":
+		switch value := v.(type) {
+		case bool:
+			return strconv.FormatBool(value)
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		case int:
+			return strconv.Itoa(value)
+		}
+	case "number":
+		if value, ok := v.(string); ok {
+			if n, err := strconv.ParseFloat(value, 64); err == nil {
+				return n
+			}
+		}
+	case "bool":
+		if value, ok := v.(string); ok {
+			if b, err := strconv.ParseBool(value); err == nil {
+				return b
+			}
+		}
+	}
+	open := strings.Index(t, "(")
+	if open == -1 || !strings.HasSuffix(t, ")") {
+		return v
+	}
+	element := t[open+1 : len(t)-1]
+	switch t[:open] {
+	case "list", "set":
+		if items, ok := v.([]interface{}); ok {
+			list := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				list = append(list, Coerce(element, item))
+			}
+			return list
+		}
+	case "map":
+		if items, ok := v.(map[string]interface{}); ok {
+			m := make(map[string]interface{}, len(items))
+			for key, item := range items {
+				m[key] = Coerce(element, item)
+			}
+			return m
+		}
+	}
+	return v
+}
+
 // Nil represents a 'nil' value which is marshaled to `null` when empty for JSON and YAML
 type Nil types.Nil
 
@@ -257,7 +317,8 @@ func (l List) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	return e.EncodeToken(start.End())
 }
 
-// Map represents a 'map' of values
+// Map represents a 'map' of values. Its keys are marshaled in sorted order
+// by all the structured formats, so the output is stable across runs.
 type Map map[string]interface{}
 
 // Underlying returns the underlying elements in the form of 'map[string]interface {}'
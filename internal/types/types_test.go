@@ -50,3 +50,85 @@ func testPrimitive(t *testing.T, tests []testprimitive) {
 		}
 	}
 }
+
+func TestCoerce(t *testing.T) {
+	tests := []struct {
+		name     string
+		types    string
+		value    interface{}
+		expected interface{}
+	}{
+		{
+			name:     "nil value",
+			types:    "number",
+			value:    nil,
+			expected: nil,
+		},
+		{
+			name:     "string to number",
+			types:    "number",
+			value:    "19",
+			expected: float64(19),
+		},
+		{
+			name:     "invalid string to number",
+			types:    "number",
+			value:    "foo",
+			expected: "foo",
+		},
+		{
+			name:     "string to bool",
+			types:    "bool",
+			value:    "true",
+			expected: true,
+		},
+		{
+			name:     "number to string",
+			types:    "string",
+			value:    float64(15.75),
+			expected: "15.75",
+		},
+		{
+			name:     "bool to string",
+			types:    "string",
+			value:    false,
+			expected: "false",
+		},
+		{
+			name:     "list of numbers",
+			types:    "list(number)",
+			value:    []interface{}{"1", "2"},
+			expected: []interface{}{float64(1), float64(2)},
+		},
+		{
+			name:     "set of strings",
+			types:    "set(string)",
+			value:    []interface{}{float64(1), "a"},
+			expected: []interface{}{"1", "a"},
+		},
+		{
+			name:     "map of bools",
+			types:    "map(bool)",
+			value:    map[string]interface{}{"a": "false"},
+			expected: map[string]interface{}{"a": false},
+		},
+		{
+			name:     "type any",
+			types:    "any",
+			value:    "19",
+			expected: "19",
+		},
+		{
+			name:     "type empty",
+			types:    "",
+			value:    []interface{}{"1"},
+			expected: []interface{}{"1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, Coerce(tt.types, tt.value))
+		})
+	}
+}
@@ -30,6 +30,11 @@ func init() {
 	}
 }
 
+// Short return the version of the binary without commit hash and build date
+func Short() string {
+	return strings.TrimSuffix(version, " "+commitHash)
+}
+
 // Full return the full version of the binary including commit hash and build date
 func Full() string {
 	if !strings.HasSuffix(version, commitHash) {
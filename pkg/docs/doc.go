@@ -0,0 +1,3 @@
+// Package docs provides generating documentation of a Terraform Module
+// programmatically, the same way as terraform-docs CLI does
+package docs
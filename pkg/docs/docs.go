@@ -0,0 +1,24 @@
+package docs
+
+import (
+	"github.com/segmentio/terraform-docs/internal/cli"
+)
+
+// Config represents all the available config options, the same as the ones
+// passed through CLI or read from '.terraform-docs.yml'
+type Config = cli.Config
+
+// DefaultConfig returns new instance of Config with default values set,
+// Formatter has to be set on it before generating (e.g. "markdown table")
+func DefaultConfig() *Config {
+	return cli.DefaultConfig()
+}
+
+// Generate normalizes and validates a copy of the provided Config, which is
+// left untouched, and returns the rendered output of module at 'path' with
+// the formatter of Config. Nothing gets written into Config.Output.File, the
+// output is only returned. Warnings of parsing the module are written into
+// stderr, unless Config.Quiet is set.
+func Generate(config *Config, path string) (string, error) {
+	return cli.Generate(config, path)
+}
@@ -0,0 +1,91 @@
+package docs
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		contains  []string
+		wantErr   string
+	}{
+		{
+			name:      "markdown table",
+			formatter: "markdown table",
+			contains:  []string{"## Inputs", "| input\\_with\\_underscores | A variable with underscores. |"},
+		},
+		{
+			name:      "json",
+			formatter: "json",
+			contains:  []string{"\"inputs\": [", "\"name\": \"input_with_underscores\""},
+		},
+		{
+			name:      "missing formatter",
+			formatter: "",
+			wantErr:   "formatter '' not found",
+		},
+		{
+			name:      "unknown formatter",
+			formatter: "foo",
+			wantErr:   "formatter 'foo' not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.Formatter = tt.formatter
+
+			actual, err := Generate(config, filepath.Join("..", "..", "examples"))
+			if tt.wantErr != "" {
+				assert.NotNil(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			assert.Nil(err)
+			for _, s := range tt.contains {
+				assert.Contains(actual, s)
+			}
+		})
+	}
+}
+
+func TestGenerateInvalidConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	config := DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Settings.Indent = -1
+
+	_, err := Generate(config, filepath.Join("..", "..", "examples"))
+	assert.EqualError(err, "value of '--indent' can't be negative")
+}
+
+func TestGenerateKeepsConfig(t *testing.T) {
+	assert := assert.New(t)
+
+	config := DefaultConfig()
+	config.Formatter = "markdown table"
+	config.Sections.Show = []string{"inputs"}
+	config.Settings.Indent = 3
+
+	expected := DefaultConfig()
+	expected.Formatter = "markdown table"
+	expected.Sections.Show = []string{"inputs"}
+	expected.Settings.Indent = 3
+
+	first, err := Generate(config, filepath.Join("..", "..", "examples"))
+	assert.Nil(err)
+	assert.Equal(expected, config)
+
+	// the same Config renders the same output again
+	second, err := Generate(config, filepath.Join("..", "..", "examples"))
+	assert.Nil(err)
+	assert.Equal(first, second)
+}
@@ -1,35 +1,199 @@
 package print
 
+// sections in the order they are rendered by default
+var defaultSectionsOrder = []string{"header", "usage", "requirements", "providers", "modules", "resources", "data-sources", "inputs", "outputs", "checks", "moved", "imports", "footer"}
+
 // Settings represents all settings
 type Settings struct {
-	// EscapeCharacters escapes special characters (such as _ * in Markdown and > < in JSON) (default: true)
+	// AnchorStyle controls how slugs of headings, which table of contents links to, are generated to match
+	// the platform the document is rendered on [available: github, gitlab] (default: github)
+	// scope: Markdown
+	AnchorStyle string
+
+	// BadgeStyle controls how required and sensitive indicators are rendered, 'text' as yes/no, 'emoji'
+	// as ✓ and 🔒 and 'shield' as shields.io badges [available: text, emoji, shield] (default: text)
+	// scope: Markdown
+	BadgeStyle string
+
+	// BooleanStyle controls how required and sensitive indicators are rendered with 'text' BadgeStyle,
+	// 'yes-no' as yes/no, 'true-false' as true/false and 'check-cross' as ✓/✗
+	// [available: yes-no, true-false, check-cross] (default: yes-no)
+	// scope: Markdown
+	BooleanStyle string
+
+	// CollapseDescriptions wraps descriptions of inputs longer than CollapseThreshold in collapsible block (default: false)
 	// scope: Markdown
-	EscapeCharacters bool
+	CollapseDescriptions bool
+
+	// CollapseThreshold is the length of descriptions above which they get collapsed (default: 200)
+	// scope: Markdown
+	CollapseThreshold int
+
+	// Compact emits minified JSON, without any indentation and newlines (default: false)
+	// scope: JSON
+	Compact bool
+
+	// DefaultsAsHCL renders default values of inputs in HCL syntax (e.g. { key = "value" }) instead of JSON (default: false)
+	// scope: Asciidoc, Markdown
+	DefaultsAsHCL bool
+
+	// EscapeMode controls escaping of special characters, 'all' escapes Markdown (such as _ *) and HTML (such as < >)
+	// characters, 'markdown' only the former and 'none' leaves text untouched [available: all, markdown, none] (default: markdown)
+	// scope: JSON, Markdown, RST
+	EscapeMode string
 
 	// EscapePipe escapes pipe character in Markdown (default: true)
 	// scope: Markdown
 	EscapePipe bool
 
-	// IndentLevel control the indentation of AsciiDoc and Markdown headers [available: 1, 2, 3, 4, 5] (default: 2)
-	// scope: Asciidoc, Markdown
+	// ExtractExamples renders '@example ... @end' blocks of descriptions of inputs as code blocks apart from the description (default: false)
+	// scope: Markdown
+	ExtractExamples bool
+
+	// FormatComplexTypes render complex types of inputs (e.g. object, map, list) as formatted 'hcl' code blocks (default: false)
+	// scope: Markdown
+	FormatComplexTypes bool
+
+	// GroupByFile groups inputs and outputs under subheadings of the file they're declared in (default: false)
+	// scope: Markdown
+	GroupByFile bool
+
+	// HeadingBaseLevel control the level of AsciiDoc, Confluence, Markdown and reStructuredText headers [available: 1, 2, 3, 4, 5] (default: 2)
+	// scope: Asciidoc, Confluence, Markdown, RST
+	HeadingBaseLevel int
+
+	// HideEmpty omits the subsections of Markdown document which have no items (e.g. 'Optional Inputs') instead of stating they're empty (default: false)
+	// scope: Markdown
+	HideEmpty bool
+
+	// HideHeadings omits the headings of sections, to print only one section on its own (default: false)
+	// scope: Asciidoc, Confluence, Markdown, Pretty, RST
+	HideHeadings bool
+
+	// HiddenColumns hides columns of inputs table [available: default, type] (default: [])
+	// scope: Confluence, Markdown, RST
+	HiddenColumns []string
+
+	// InputsAsSubsections renders inputs of Markdown document as subsections one level deeper, with a stable anchor to link to (default: false)
+	// scope: Markdown
+	InputsAsSubsections bool
+
+	// IndentLevel control the number of spaces to indent JSON, TOML, XML and YAML with (default: 2)
+	// scope: JSON, TOML, XML, YAML
 	IndentLevel int
 
+	// LinkResources links resources and data sources to their documentation on Terraform Registry (default: false)
+	// scope: Markdown
+	LinkResources bool
+
+	// MarkMissingDefaults render inputs without default value with explicit "n/a" or "required" marker (default: false)
+	// scope: Asciidoc, Confluence, CSV, Markdown, RST
+	MarkMissingDefaults bool
+
+	// MaxLineLength wraps lines of descriptions longer than the value at word boundaries, 0 means unlimited (default: 0)
+	// scope: Markdown
+	MaxLineLength int
+
+	// MetaTimestamp includes the time of generation in "Meta" information (default: true)
+	// scope: Global
+	MetaTimestamp bool
+
 	// OutputValues ailrghaekrgj
 	// scope: Global
 	OutputValues bool
 
+	// PartitionSensitiveOutputs groups outputs of JSON into 'sensitive' and 'public' lists (default: false)
+	// scope: JSON
+	PartitionSensitiveOutputs bool
+
+	// Placeholder is the text rendered in place of missing values (e.g. defaults, descriptions or versions) (default: n/a)
+	// scope: Asciidoc, Markdown
+	Placeholder string
+
+	// RawDescriptions renders descriptions of document as written, keeping their multi-line Markdown (default: false)
+	// scope: Markdown
+	RawDescriptions bool
+
+	// SectionTitles overrides the default title of sections, keyed by section name (e.g. 'inputs') (default: none)
+	// scope: Asciidoc, Confluence, Markdown, RST
+	SectionTitles map[string]string
+
+	// SectionsOrder is the order of sections to render, the ones not listed follow in their default order (default: [])
+	// scope: Asciidoc, Confluence, Markdown, Pretty, RST
+	SectionsOrder []string
+
+	// SensitiveAlerts shows sensitive inputs with GitHub '> [!WARNING]' alert, requires ShowSensitivity (default: false)
+	// scope: Markdown
+	SensitiveAlerts bool
+
+	// SensitiveMark is the text or emoji marking sensitive items with 'text' BadgeStyle (default: yes)
+	// scope: Markdown
+	SensitiveMark string
+
+	// ShowAnchor generate HTML anchors of providers and link requirements to them (default: false)
+	// scope: Markdown
+	ShowAnchor bool
+
+	// ShowChecks show "Checks" information, the 'check' blocks of the module with their assertions (default: false)
+	// scope: Global
+	ShowChecks bool
+
 	// ShowColor print "colorized" version of result in the terminal (default: true)
 	// scope: Pretty
 	ShowColor bool
 
+	// ShowConstraintSource show file and line of version constraints of requirements in a Source column (default: false)
+	// scope: Asciidoc, Markdown
+	ShowConstraintSource bool
+
+	// ShowDataSources show "Data Sources" section (default: true)
+	// scope: Global
+	ShowDataSources bool
+
+	// ShowDependsOn show 'depends_on' addresses of module calls (default: false)
+	// scope: Markdown
+	ShowDependsOn bool
+
+	// ShowFooter show "Footer" module information (default: false)
+	// scope: Global
+	ShowFooter bool
+
 	// ShowHeader show "Header" module information (default: true)
 	// scope: Global
 	ShowHeader bool
 
+	// ShowImports show "Imports" information, the 'import' blocks of the module (default: false)
+	// scope: Global
+	ShowImports bool
+
 	// ShowInputs show "Inputs" information (default: true)
 	// scope: Global
 	ShowInputs bool
 
+	// ShowInputValues show actual values of inputs, taken from outputs of the same name, requires OutputValues (default: false)
+	// scope: Global
+	ShowInputValues bool
+
+	// ShowLockedVersions show versions of providers locked in '.terraform.lock.hcl' (default: false)
+	// scope: Global
+	ShowLockedVersions bool
+
+	// ShowMeta show "Meta" information, the version of terraform-docs and the time of generation (default: false)
+	// scope: Global
+	ShowMeta bool
+
+	// ShowModules show "Modules" information (default: true)
+	// scope: Global
+	ShowModules bool
+
+	// ShowMoved show "Moved" information, the 'moved' blocks of the module (default: false)
+	// scope: Global
+	ShowMoved bool
+
+	// ShowNullable show whether inputs accept 'null' as their value (default: false)
+	// scope: Global
+	ShowNullable bool
+
 	// ShowOutputs show "Outputs" information (default: true)
 	// scope: Global
 	ShowOutputs bool
@@ -38,18 +202,42 @@ type Settings struct {
 	// scope: Global
 	ShowProviders bool
 
+	// ShowProviderSources show namespaced source of providers (e.g. 'hashicorp/aws') (default: false)
+	// scope: Asciidoc, Confluence, Markdown, RST
+	ShowProviderSources bool
+
 	// ShowRequired show "Required" column when generating Markdown (default: true)
-	// scope: Markdown
+	// scope: Confluence, Markdown, RST
 	ShowRequired bool
 
 	// ShowSensitivity show "Sensitive" column when generating Markdown (default: true)
-	// scope: Markdown
+	// scope: Confluence, Markdown, RST
 	ShowSensitivity bool
 
 	// ShowRequirements show "Requirements" section (default: true)
 	// scope: Global
 	ShowRequirements bool
 
+	// ShowResources show "Resources" section (default: true)
+	// scope: Global
+	ShowResources bool
+
+	// ShowSummary show a line of counts of inputs, outputs, providers, etc. after the header (default: false)
+	// scope: Markdown
+	ShowSummary bool
+
+	// ShowTOC show a table of contents linking to the sections (default: false)
+	// scope: Markdown
+	ShowTOC bool
+
+	// ShowUsage show "Usage" information, the example of using the module read from a file (default: false)
+	// scope: Global
+	ShowUsage bool
+
+	// ShowValidation show 'validation' rules of inputs (default: false)
+	// scope: Global
+	ShowValidation bool
+
 	// SortByName sorted rendering of inputs and outputs (default: true)
 	// scope: Global
 	SortByName bool
@@ -61,25 +249,139 @@ type Settings struct {
 	// SortByType sort items (inputs, outputs) by type alphabetically (default: false)
 	// scope: Global
 	SortByType bool
+
+	// SplitRequiredOptional renders required and optional inputs of Markdown document in separate subsections of the inputs section (default: false)
+	// scope: Markdown
+	SplitRequiredOptional bool
+
+	// SplitRequirements shows Terraform and provider requirements in separate subsections (default: false)
+	// scope: Asciidoc, Markdown
+	SplitRequirements bool
+
+	// Template is the content of the user-provided Go template to render (default: "")
+	// scope: Template
+	Template string
+
+	// TrimDescription renders only the first sentence of descriptions of inputs and outputs (default: false)
+	// scope: Markdown
+	TrimDescription bool
+
+	// TypeMaxLength truncates types of inputs longer than the value with an ellipsis in tables, 0 means unlimited (default: 0)
+	// scope: Asciidoc, Confluence, Markdown, RST
+	TypeMaxLength int
+
+	// WrapAt wraps descriptions and default values in cells of tables at the width with '<br>', 0 means unlimited (default: 0)
+	// scope: Markdown
+	WrapAt int
 }
 
 // NewSettings returns new instance of Settings
 func NewSettings() *Settings {
 	return &Settings{
-		EscapeCharacters: true,
-		EscapePipe:       true,
-		IndentLevel:      2,
-		OutputValues:     false,
-		ShowColor:        true,
-		ShowHeader:       true,
-		ShowInputs:       true,
-		ShowOutputs:      true,
-		ShowProviders:    true,
-		ShowRequired:     true,
-		ShowSensitivity:  true,
-		ShowRequirements: true,
-		SortByName:       true,
-		SortByRequired:   false,
-		SortByType:       false,
+		AnchorStyle:               "github",
+		BadgeStyle:                "text",
+		BooleanStyle:              "yes-no",
+		CollapseDescriptions:      false,
+		CollapseThreshold:         200,
+		Compact:                   false,
+		DefaultsAsHCL:             false,
+		EscapeMode:                "markdown",
+		EscapePipe:                true,
+		ExtractExamples:           false,
+		FormatComplexTypes:        false,
+		GroupByFile:               false,
+		HeadingBaseLevel:          2,
+		HiddenColumns:             []string{},
+		HideEmpty:                 false,
+		HideHeadings:              false,
+		IndentLevel:               2,
+		LinkResources:             false,
+		InputsAsSubsections:       false,
+		MarkMissingDefaults:       false,
+		MaxLineLength:             0,
+		MetaTimestamp:             true,
+		OutputValues:              false,
+		PartitionSensitiveOutputs: false,
+		Placeholder:               "n/a",
+		RawDescriptions:           false,
+		SectionTitles:             map[string]string{},
+		SectionsOrder:             []string{},
+		SensitiveAlerts:           false,
+		SensitiveMark:             "yes",
+		ShowAnchor:                false,
+		ShowChecks:                false,
+		ShowColor:                 true,
+		ShowConstraintSource:      false,
+		ShowDataSources:           true,
+		ShowDependsOn:             false,
+		ShowFooter:                false,
+		ShowHeader:                true,
+		ShowImports:               false,
+		ShowInputs:                true,
+		ShowInputValues:           false,
+		ShowLockedVersions:        false,
+		ShowMeta:                  false,
+		ShowModules:               true,
+		ShowMoved:                 false,
+		ShowNullable:              false,
+		ShowOutputs:               true,
+		ShowProviders:             true,
+		ShowProviderSources:       false,
+		ShowRequired:              true,
+		ShowSensitivity:           true,
+		ShowRequirements:          true,
+		ShowResources:             true,
+		ShowSummary:               false,
+		ShowTOC:                   false,
+		ShowUsage:                 false,
+		ShowValidation:            false,
+		SortByName:                true,
+		SortByRequired:            false,
+		SortByType:                false,
+		SplitRequiredOptional:     false,
+		SplitRequirements:         false,
+		Template:                  "",
+		TrimDescription:           false,
+		TypeMaxLength:             0,
+		WrapAt:                    0,
 	}
 }
+
+// OrderedSections returns the names of all the sections in the order they
+// are rendered, the ones listed in SectionsOrder first and the rest in their
+// default order after them.
+func (s *Settings) OrderedSections() []string {
+	sections := make([]string, 0, len(defaultSectionsOrder))
+	for _, section := range s.SectionsOrder {
+		if contains(defaultSectionsOrder, section) && !contains(sections, section) {
+			sections = append(sections, section)
+		}
+	}
+	for _, section := range defaultSectionsOrder {
+		if !contains(sections, section) {
+			sections = append(sections, section)
+		}
+	}
+	return sections
+}
+
+func contains(list []string, name string) bool {
+	for _, i := range list {
+		if i == name {
+			return true
+		}
+	}
+	return false
+}
+
+// EscapeCharacters indicates if special characters (such as _ * in Markdown
+// and > < in JSON) should be escaped, i.e. EscapeMode is 'all' or 'markdown'
+func (s *Settings) EscapeCharacters() bool {
+	return s.EscapeMode == "all" || s.EscapeMode == "markdown"
+}
+
+// EscapeHTML indicates if HTML characters (such as < >) of descriptions
+// should be escaped too, i.e. EscapeMode is 'all'
+func (s *Settings) EscapeHTML() bool {
+	return s.EscapeMode == "all"
+}
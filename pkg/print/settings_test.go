@@ -0,0 +1,53 @@
+package print
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedSections(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    []string
+		expected []string
+	}{
+		{
+			name:     "default order",
+			order:    []string{},
+			expected: []string{"header", "usage", "requirements", "providers", "modules", "resources", "data-sources", "inputs", "outputs", "checks", "moved", "imports", "footer"},
+		},
+		{
+			name:     "explicit order first",
+			order:    []string{"requirements", "inputs"},
+			expected: []string{"requirements", "inputs", "header", "usage", "providers", "modules", "resources", "data-sources", "outputs", "checks", "moved", "imports", "footer"},
+		},
+		{
+			name:     "all sections",
+			order:    []string{"footer", "imports", "moved", "checks", "outputs", "inputs", "data-sources", "resources", "modules", "providers", "requirements", "usage", "header"},
+			expected: []string{"footer", "imports", "moved", "checks", "outputs", "inputs", "data-sources", "resources", "modules", "providers", "requirements", "usage", "header"},
+		},
+		{
+			name:     "ignore unknown and repeated sections",
+			order:    []string{"outputs", "foo", "outputs"},
+			expected: []string{"outputs", "header", "usage", "requirements", "providers", "modules", "resources", "data-sources", "inputs", "checks", "moved", "imports", "footer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := &Settings{
+				SectionsOrder: tt.order,
+			}
+			assert.Equal(tt.expected, settings.OrderedSections())
+		})
+	}
+}
+
+func TestNewSettingsHeadingBaseLevel(t *testing.T) {
+	assert := assert.New(t)
+
+	settings := NewSettings()
+	assert.Equal(2, settings.HeadingBaseLevel)
+	assert.Equal(2, settings.IndentLevel)
+}
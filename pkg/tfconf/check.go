@@ -0,0 +1,15 @@
+package tfconf
+
+// Check represents a 'check' block of Terraform module, which validates the
+// infrastructure with its assertions, without blocking the operations.
+type Check struct {
+	Name       string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Assertions []*Assertion `json:"assertions" toml:"assertions" xml:"assertions>assert" yaml:"assertions"`
+	Position   Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
+
+// Assertion represents an 'assert' block of a check.
+type Assertion struct {
+	Condition    string `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage string `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
+}
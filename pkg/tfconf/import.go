@@ -0,0 +1,9 @@
+package tfconf
+
+// Import represents an 'import' block of Terraform module, which adopts an
+// existing infrastructure object, identified by its id, into a resource.
+type Import struct {
+	To       string   `json:"to" toml:"to" xml:"to" yaml:"to"`
+	ID       string   `json:"id" toml:"id" xml:"id" yaml:"id"`
+	Position Position `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
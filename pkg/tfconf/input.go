@@ -1,19 +1,31 @@
 package tfconf
 
 import (
+	"bytes"
 	"encoding/json"
+	"strings"
 
 	"github.com/segmentio/terraform-docs/internal/types"
 )
 
 // Input represents a Terraform input.
 type Input struct {
-	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
-	Type        types.String `json:"type" toml:"type" xml:"type" yaml:"type"`
-	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
-	Default     types.Value  `json:"default" toml:"default" xml:"default" yaml:"default"`
-	Required    bool         `json:"required" toml:"required" xml:"required" yaml:"required"`
-	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Name        string        `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Type        types.String  `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Description types.String  `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Default     types.Value   `json:"default" toml:"default" xml:"default" yaml:"default"`
+	Required    bool          `json:"required" toml:"required" xml:"required" yaml:"required"`
+	Sensitive   bool          `json:"sensitive,omitempty" toml:"sensitive,omitempty" xml:"sensitive,omitempty" yaml:"sensitive,omitempty"`
+	Nullable    *bool         `json:"nullable,omitempty" toml:"nullable,omitempty" xml:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Value       types.Value   `json:"value,omitempty" toml:"value,omitempty" xml:"value,omitempty" yaml:"value,omitempty"`
+	Validations []*Validation `json:"validations,omitempty" toml:"validations,omitempty" xml:"validation,omitempty" yaml:"validations,omitempty"`
+	Position    Position      `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
+
+// Validation represents a 'validation' rule of a Terraform input.
+type Validation struct {
+	Condition    string `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage string `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
 }
 
 // GetValue returns JSON representation of the 'Default' value, which is an 'interface'.
@@ -34,6 +46,46 @@ func (i *Input) GetValue() string {
 	return value // everything else
 }
 
+// IsNullable indicates a Terraform variable accepts 'null' as its value,
+// which is the case unless it sets 'nullable = false' explicitly.
+func (i *Input) IsNullable() bool {
+	return i.Nullable == nil || *i.Nullable
+}
+
+// GetActualValue returns JSON representation of the 'Value', which is the actual
+// value of the input resolved from the output of the same name, if any.
+func (i *Input) GetActualValue() string {
+	if i.Value == nil {
+		return ""
+	}
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false) // e.g. '<sensitive>'
+	if err := encoder.Encode(i.Value); err != nil {
+		panic(err)
+	}
+	value := strings.TrimSuffix(buffer.String(), "\n")
+	if value == `null` {
+		return "" // types.Nil
+	}
+	return value // everything else
+}
+
+// GetDefaultMarker returns an explicit marker for an input without any default
+// value (i.e. required), to not be mistaken for an empty default value: "n/a"
+// if requirement of inputs is shown separately, otherwise "required". Returns
+// empty string if the input has a default value.
+func (i *Input) GetDefaultMarker(showRequired bool) string {
+	if i.HasDefault() {
+		return ""
+	}
+	if showRequired {
+		return "n/a"
+	}
+	return "required"
+}
+
 // HasDefault indicates if a Terraform variable has a default value set.
 func (i *Input) HasDefault() bool {
 	return i.Default.HasDefault() || !i.Required
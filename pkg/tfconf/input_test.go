@@ -199,3 +199,99 @@ func TestInputValue(t *testing.T) {
 		})
 	}
 }
+
+func TestInputActualValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    types.Value
+		expected string
+	}{
+		{
+			name:     "input without actual value",
+			value:    nil,
+			expected: "",
+		},
+		{
+			name:     "input with null actual value",
+			value:    types.ValueOf(nil),
+			expected: "",
+		},
+		{
+			name:     "input with primitive actual value",
+			value:    types.ValueOf("foo"),
+			expected: `"foo"`,
+		},
+		{
+			name:     "input with sensitive actual value",
+			value:    types.ValueOf("<sensitive>"),
+			expected: `"<sensitive>"`,
+		},
+		{
+			name:     "input with list actual value",
+			value:    types.ValueOf([]interface{}{"a", "b"}),
+			expected: "[\n  \"a\",\n  \"b\"\n]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			input := Input{
+				Name:  "input",
+				Value: tt.value,
+			}
+			assert.Equal(tt.expected, input.GetActualValue())
+		})
+	}
+}
+
+func TestInputDefaultMarker(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        Input
+		showRequired bool
+		expected     string
+	}{
+		{
+			name: "required input with required shown",
+			input: Input{
+				Default:  types.ValueOf(nil),
+				Required: true,
+			},
+			showRequired: true,
+			expected:     "n/a",
+		},
+		{
+			name: "required input with required hidden",
+			input: Input{
+				Default:  types.ValueOf(nil),
+				Required: true,
+			},
+			showRequired: false,
+			expected:     "required",
+		},
+		{
+			name: "optional input with empty default",
+			input: Input{
+				Default:  types.ValueOf(""),
+				Required: false,
+			},
+			showRequired: false,
+			expected:     "",
+		},
+		{
+			name: "optional input with null default",
+			input: Input{
+				Default:  types.ValueOf(nil),
+				Required: false,
+			},
+			showRequired: false,
+			expected:     "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, tt.input.GetDefaultMarker(tt.showRequired))
+		})
+	}
+}
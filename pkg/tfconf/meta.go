@@ -0,0 +1,8 @@
+package tfconf
+
+// Meta represents the information about generation of the document, the
+// version of terraform-docs and the time it's generated at.
+type Meta struct {
+	Version   string `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Timestamp string `json:"timestamp,omitempty" toml:"timestamp,omitempty" xml:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+}
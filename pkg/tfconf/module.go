@@ -7,18 +7,34 @@ import (
 // Module represents a Terraform module. It consists of
 //
 // - Header       ('header' json key):    Module header found in shape of multi line comments at the beginning of 'main.tf'
+// - Footer       ('footer' json key):    Module footer found in shape of multi line comments at the beginning of '--footer-from' file
+// - Usage        ('usage' json key):     Example usage of module read from '--include-examples' file, only if the section is shown
 // - Inputs       ('inputs' json key):    List of input 'variables' extracted from the Terraform module .tf files
 // - Outputs      ('outputs' json key):   List of 'outputs' extracted from Terraform module .tf files
 // - Providers    ('providers' json key): List of 'providers' extracted from resources used in Terraform module
 // - Requirements ('header' json key):    List of 'requirements' extracted from the Terraform module .tf files
+// - Resources    ('resources' json key): List of managed and data 'resources' used in Terraform module (see 'mode')
+// - ModuleCalls  ('modules' json key):   List of child 'modules' called by Terraform module
+// - Checks       ('checks' json key):    List of 'check' blocks of Terraform module, only if the section is shown
+// - Moved        ('moved' json key):     List of 'moved' blocks of Terraform module, only if the section is shown
+// - Imports      ('imports' json key):   List of 'import' blocks of Terraform module, only if the section is shown
+// - Meta         ('meta' json key):      Version of terraform-docs and time of generation, only if the section is shown
 type Module struct {
 	XMLName xml.Name `json:"-" toml:"-" xml:"module" yaml:"-"`
 
 	Header       string         `json:"header" toml:"header" xml:"header" yaml:"header"`
+	Footer       string         `json:"footer,omitempty" toml:"footer,omitempty" xml:"footer,omitempty" yaml:"footer,omitempty"`
+	Usage        string         `json:"usage,omitempty" toml:"usage,omitempty" xml:"usage,omitempty" yaml:"usage,omitempty"`
 	Inputs       []*Input       `json:"inputs" toml:"inputs" xml:"inputs>input" yaml:"inputs"`
 	Outputs      []*Output      `json:"outputs" toml:"outputs" xml:"outputs>output" yaml:"outputs"`
 	Providers    []*Provider    `json:"providers" toml:"providers" xml:"providers>provider" yaml:"providers"`
 	Requirements []*Requirement `json:"requirements" toml:"requirements" xml:"requirements>requirement" yaml:"requirements"`
+	Resources    []*Resource    `json:"resources" toml:"resources" xml:"resources>resource" yaml:"resources"`
+	ModuleCalls  []*ModuleCall  `json:"modules" toml:"modules" xml:"modules>module" yaml:"modules"`
+	Checks       []*Check       `json:"checks,omitempty" toml:"checks,omitempty" xml:"checks,omitempty" yaml:"checks,omitempty"`
+	Moved        []*Moved       `json:"moved,omitempty" toml:"moved,omitempty" xml:"moved,omitempty" yaml:"moved,omitempty"`
+	Imports      []*Import      `json:"imports,omitempty" toml:"imports,omitempty" xml:"imports,omitempty" yaml:"imports,omitempty"`
+	Meta         *Meta          `json:"meta,omitempty" toml:"meta,omitempty" xml:"meta,omitempty" yaml:"meta,omitempty"`
 
 	RequiredInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
 	OptionalInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
@@ -29,16 +45,51 @@ func (m *Module) HasHeader() bool {
 	return len(m.Header) > 0
 }
 
+// HasFooter indicates if the module has footer.
+func (m *Module) HasFooter() bool {
+	return len(m.Footer) > 0
+}
+
+// HasUsage indicates if the module has example usage.
+func (m *Module) HasUsage() bool {
+	return len(m.Usage) > 0
+}
+
 // HasInputs indicates if the module has inputs.
 func (m *Module) HasInputs() bool {
 	return len(m.Inputs) > 0
 }
 
+// HasSensitiveInputs indicates if the module has inputs marked as sensitive.
+func (m *Module) HasSensitiveInputs() bool {
+	for _, input := range m.Inputs {
+		if input.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
 // HasOutputs indicates if the module has outputs.
 func (m *Module) HasOutputs() bool {
 	return len(m.Outputs) > 0
 }
 
+// HasChecks indicates if the module has check blocks.
+func (m *Module) HasChecks() bool {
+	return len(m.Checks) > 0
+}
+
+// HasMoved indicates if the module has moved blocks.
+func (m *Module) HasMoved() bool {
+	return len(m.Moved) > 0
+}
+
+// HasImports indicates if the module has import blocks.
+func (m *Module) HasImports() bool {
+	return len(m.Imports) > 0
+}
+
 // HasProviders indicates if the module has providers.
 func (m *Module) HasProviders() bool {
 	return len(m.Providers) > 0
@@ -48,3 +99,57 @@ func (m *Module) HasProviders() bool {
 func (m *Module) HasRequirements() bool {
 	return len(m.Requirements) > 0
 }
+
+// TerraformRequirements returns the Terraform core version requirements of the module.
+func (m *Module) TerraformRequirements() []*Requirement {
+	requirements := make([]*Requirement, 0, len(m.Requirements))
+	for _, r := range m.Requirements {
+		if r.Name == "terraform" {
+			requirements = append(requirements, r)
+		}
+	}
+	return requirements
+}
+
+// ProviderRequirements returns the provider version requirements of the module.
+func (m *Module) ProviderRequirements() []*Requirement {
+	requirements := make([]*Requirement, 0, len(m.Requirements))
+	for _, r := range m.Requirements {
+		if r.Name != "terraform" {
+			requirements = append(requirements, r)
+		}
+	}
+	return requirements
+}
+
+// HasResources indicates if the module has resources.
+func (m *Module) HasResources() bool {
+	return len(m.Resources) > 0
+}
+
+// ManagedResources returns the managed resources used in the module.
+func (m *Module) ManagedResources() []*Resource {
+	resources := make([]*Resource, 0, len(m.Resources))
+	for _, r := range m.Resources {
+		if r.Mode != "data" {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
+// DataResources returns the data resources (i.e. data sources) read by the module.
+func (m *Module) DataResources() []*Resource {
+	resources := make([]*Resource, 0, len(m.Resources))
+	for _, r := range m.Resources {
+		if r.Mode == "data" {
+			resources = append(resources, r)
+		}
+	}
+	return resources
+}
+
+// HasModuleCalls indicates if the module calls child modules.
+func (m *Module) HasModuleCalls() bool {
+	return len(m.ModuleCalls) > 0
+}
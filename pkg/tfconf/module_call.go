@@ -0,0 +1,14 @@
+package tfconf
+
+import (
+	"github.com/segmentio/terraform-docs/internal/types"
+)
+
+// ModuleCall represents a call to a child module in Terraform module.
+type ModuleCall struct {
+	Name      string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Source    string       `json:"source" toml:"source" xml:"source" yaml:"source"`
+	Version   types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	DependsOn []string     `json:"depends_on,omitempty" toml:"depends_on,omitempty" xml:"depends_on,omitempty" yaml:"depends_on,omitempty"`
+	Position  Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
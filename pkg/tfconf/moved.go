@@ -0,0 +1,9 @@
+package tfconf
+
+// Moved represents a 'moved' block of Terraform module, which records the
+// previous address of a resource or a module call after refactoring.
+type Moved struct {
+	From     string   `json:"from" toml:"from" xml:"from" yaml:"from"`
+	To       string   `json:"to" toml:"to" xml:"to" yaml:"to"`
+	Position Position `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
@@ -1,7 +1,20 @@
 package tfconf
 
+import (
+	"fmt"
+)
+
 // Position represents position of Terraform input or output in a file.
 type Position struct {
 	Filename string `json:"-" toml:"-" xml:"-" yaml:"-"`
 	Line     int    `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
+
+// String returns the position in 'filename:line' format, or empty
+// string if the position is unknown.
+func (p Position) String() string {
+	if p.Filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", p.Filename, p.Line)
+}
@@ -11,6 +11,8 @@ type Provider struct {
 	Name     string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Alias    types.String `json:"alias" toml:"alias" xml:"alias" yaml:"alias"`
 	Version  types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Locked   types.String `json:"locked,omitempty" toml:"locked,omitempty" xml:"locked,omitempty" yaml:"locked,omitempty"`
+	Source   types.String `json:"source,omitempty" toml:"source,omitempty" xml:"source,omitempty" yaml:"source,omitempty"`
 	Position Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
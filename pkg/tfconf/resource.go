@@ -0,0 +1,23 @@
+package tfconf
+
+import (
+	"fmt"
+)
+
+// Resource represents a managed or data resource used in Terraform module.
+type Resource struct {
+	Type     string   `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Name     string   `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Mode     string   `json:"mode" toml:"mode" xml:"mode" yaml:"mode"`
+	Provider string   `json:"provider" toml:"provider" xml:"provider" yaml:"provider"`
+	URL      string   `json:"url,omitempty" toml:"url,omitempty" xml:"url,omitempty" yaml:"url,omitempty"`
+	Position Position `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
+
+// FullType returns full type of the resource, prefixed with 'data.' if it's a data resource
+func (r *Resource) FullType() string {
+	if r.Mode == "data" {
+		return fmt.Sprintf("data.%s", r.Type)
+	}
+	return r.Type
+}
@@ -0,0 +1,31 @@
+package tfconf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceTypeManaged(t *testing.T) {
+	assert := assert.New(t)
+	resource := Resource{
+		Type:     "aws_s3_bucket",
+		Name:     "foo",
+		Mode:     "managed",
+		Provider: "aws",
+		Position: Position{Filename: "foo.tf", Line: 13},
+	}
+	assert.Equal("aws_s3_bucket", resource.FullType())
+}
+
+func TestResourceTypeData(t *testing.T) {
+	assert := assert.New(t)
+	resource := Resource{
+		Type:     "aws_caller_identity",
+		Name:     "current",
+		Mode:     "data",
+		Provider: "aws",
+		Position: Position{Filename: "foo.tf", Line: 13},
+	}
+	assert.Equal("data.aws_caller_identity", resource.FullType())
+}
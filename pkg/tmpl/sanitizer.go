@@ -13,7 +13,7 @@ import (
 
 // sanitizeName escapes underscore character which have special meaning in Markdown.
 func sanitizeName(name string, settings *print.Settings) string {
-	if settings.EscapeCharacters {
+	if settings.EscapeCharacters() {
 		// Escape underscore
 		name = strings.Replace(name, "_", "\\_", -1)
 	}
@@ -24,7 +24,7 @@ func sanitizeName(name string, settings *print.Settings) string {
 // for a document. (including line-break, illegal characters, code blocks etc)
 func sanitizeItemForDocument(s string, settings *print.Settings) string {
 	if s == "" {
-		return "n/a"
+		return settings.Placeholder
 	}
 	result := processSegments(
 		s,
@@ -47,23 +47,64 @@ func sanitizeItemForDocument(s string, settings *print.Settings) string {
 	return result
 }
 
+// sanitizeRawItemForDocument returns passed 'string' as written, keeping its
+// Markdown (including line-breaks) untouched. Only HTML tags outside of code
+// blocks and spans get escaped, if escape mode requires it.
+func sanitizeRawItemForDocument(s string, settings *print.Settings) string {
+	s = strings.Trim(s, "\n")
+	if strings.TrimSpace(s) == "" {
+		return settings.Placeholder
+	}
+	if !settings.EscapeHTML() {
+		return s
+	}
+	return processSegments(
+		s,
+		"```",
+		func(segment string) string {
+			return processSegments(
+				segment,
+				"`",
+				func(segment string) string {
+					segment = strings.Replace(segment, "<", "&lt;", -1)
+					segment = strings.Replace(segment, ">", "&gt;", -1)
+					return segment
+				},
+				func(segment string) string {
+					return fmt.Sprintf("`%s`", segment)
+				},
+			)
+		},
+		func(segment string) string {
+			return fmt.Sprintf("```%s```", segment)
+		},
+	)
+}
+
 // sanitizeItemForTable converts passed 'string' to suitable Markdown representation
 // for a table. (including line-break, illegal characters, code blocks etc)
 func sanitizeItemForTable(s string, settings *print.Settings) string {
 	if s == "" {
-		return "n/a"
+		return settings.Placeholder
 	}
+	// pipe is the cell separator, even inside code spans and blocks,
+	// hence it gets escaped all over the item and not per segment
+	escape := *settings
+	escape.EscapePipe = false
+
 	result := processSegments(
 		s,
 		"```",
 		func(segment string) string {
-			segment = escapeIllegalCharacters(segment, settings)
+			segment = escapeIllegalCharacters(segment, &escape)
+			segment = escapeTablePipe(segment, settings)
 			segment = convertMultiLineText(segment, true)
 			segment = normalizeURLs(segment, settings)
 			return segment
 		},
 		func(segment string) string {
 			segment = strings.TrimSpace(segment)
+			segment = escapeTablePipe(segment, settings)
 			segment = strings.Replace(segment, "\n", "<br>", -1)
 			segment = strings.Replace(segment, "\r", "", -1)
 			segment = fmt.Sprintf("<pre>%s</pre>", segment)
@@ -73,17 +114,26 @@ func sanitizeItemForTable(s string, settings *print.Settings) string {
 	return result
 }
 
+// escapeTablePipe escapes pipe character of a Markdown table cell, which
+// would otherwise be taken as the cell separator.
+func escapeTablePipe(s string, settings *print.Settings) string {
+	if settings.EscapePipe {
+		s = strings.Replace(s, "|", "\\|", -1)
+	}
+	return s
+}
+
 // sanitizeItemForAsciidocTable converts passed 'string' to suitable AsciiDoc representation
 // for a table. (including line-break, illegal characters, code blocks etc)
 func sanitizeItemForAsciidocTable(s string, settings *print.Settings) string {
 	if s == "" {
-		return "n/a"
+		return settings.Placeholder
 	}
 	result := processSegments(
 		s,
 		"```",
 		func(segment string) string {
-			segment = escapeIllegalCharacters(segment, settings)
+			segment = escapeAsciidocCharacters(segment, settings)
 			return segment
 		},
 		func(segment string) string {
@@ -142,7 +192,7 @@ func escapeIllegalCharacters(s string, settings *print.Settings) string {
 		)
 	}
 
-	if settings.EscapeCharacters {
+	if settings.EscapeCharacters() {
 		s = processSegments(
 			s,
 			"`",
@@ -188,6 +238,42 @@ func escapeIllegalCharacters(s string, settings *print.Settings) string {
 		)
 	}
 
+	// Escape HTML tags to render them as text, code spans already are
+	if settings.EscapeHTML() {
+		s = processSegments(
+			s,
+			"`",
+			func(segment string) string {
+				segment = strings.Replace(segment, "<", "&lt;", -1)
+				segment = strings.Replace(segment, ">", "&gt;", -1)
+				return segment
+			},
+			func(segment string) string {
+				return fmt.Sprintf("`%s`", segment)
+			},
+		)
+	}
+
+	return s
+}
+
+// asciidocAttributeReference matches attribute references of AsciiDoc (e.g. '{name}')
+var asciidocAttributeReference = regexp.MustCompile(`\{(\w[\w-]*)\}`)
+
+// escapeAsciidocCharacters escapes characters which have special meaning in an AsciiDoc
+// table cell into their corresponding literal. Unlike Markdown, underscore and asterisk
+// are left untouched, AsciiDoc only removes the backslash in front of recognized markup.
+func escapeAsciidocCharacters(s string, settings *print.Settings) string {
+	// Escape pipe, which is the cell separator (even inside backticks)
+	if settings.EscapePipe {
+		s = strings.Replace(s, "|", "\\|", -1)
+	}
+
+	// Escape attribute references (e.g. {name}) to prevent them from being substituted
+	if settings.EscapeCharacters() {
+		s = asciidocAttributeReference.ReplaceAllString(s, "\\{$1}")
+	}
+
 	return s
 }
 
@@ -195,7 +281,7 @@ func escapeIllegalCharacters(s string, settings *print.Settings) string {
 // to the original state. For example any underscore in the URL which
 // got escaped by 'EscapeIllegalCharacters' will be reverted back.
 func normalizeURLs(s string, settings *print.Settings) string {
-	if settings.EscapeCharacters {
+	if settings.EscapeCharacters() {
 		if urls := xurls.Strict().FindAllString(s, -1); len(urls) > 0 {
 			for _, url := range urls {
 				normalized := strings.Replace(url, "\\", "", -1)
@@ -207,14 +293,14 @@ func normalizeURLs(s string, settings *print.Settings) string {
 }
 
 // generateIndentation generates indentation of Markdown and AsciiDoc headers
-// with base level of provided 'settings.IndentLevel' plus any
+// with base level of provided 'settings.HeadingBaseLevel' plus any
 // extra level needed for subsection (e.g. 'Required Inputs' which
 // is a subsection of 'Inputs' section)
 func generateIndentation(extra int, char string, settings *print.Settings) string {
 	if char == "" {
 		return ""
 	}
-	var base = settings.IndentLevel
+	var base = settings.HeadingBaseLevel
 	if base < 1 || base > 5 {
 		base = 2
 	}
@@ -89,7 +89,7 @@ func TestSanitizeName(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				EscapeCharacters: tt.escape,
+				EscapeMode: escapeMode(tt.escape),
 			}).Build()
 			actual := sanitizeName(tt.input, settings)
 
@@ -128,7 +128,7 @@ func TestSanitizeItemForDocument(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				EscapeCharacters: tt.escapeChars,
+				EscapeMode: escapeMode(tt.escapeChars),
 			}).Build()
 			settings.EscapePipe = tt.escapePipe
 
@@ -145,6 +145,45 @@ func TestSanitizeItemForDocument(t *testing.T) {
 	}
 }
 
+func TestSanitizeRawItemForDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mode     string
+		expected string
+	}{
+		{
+			name:     "sanitize raw document item empty",
+			input:    "\n\n",
+			mode:     "markdown",
+			expected: "n/a",
+		},
+		{
+			name:     "sanitize raw document item keeps markdown",
+			input:    "Use *foo_bar* to:\n\n- create <b>it</b>\n- delete it\n",
+			mode:     "markdown",
+			expected: "Use *foo_bar* to:\n\n- create <b>it</b>\n- delete it",
+		},
+		{
+			name:     "sanitize raw document item escapes html in all mode",
+			input:    "Use *foo_bar* to:\n\n- create <b>it</b> `<id>`\n\n```\n<tag>\n```",
+			mode:     "all",
+			expected: "Use *foo_bar* to:\n\n- create &lt;b&gt;it&lt;/b&gt; `<id>`\n\n```\n<tag>\n```",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().With(&print.Settings{
+				EscapeMode: tt.mode,
+			}).Build()
+			actual := sanitizeRawItemForDocument(tt.input, settings)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestSanitizeItemForTable(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -166,12 +205,17 @@ func TestSanitizeItemForTable(t *testing.T) {
 			filename:    "codeblock",
 			escapeChars: true,
 		},
+		{
+			name:        "sanitize table item pipe",
+			filename:    "pipe",
+			escapeChars: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				EscapeCharacters: tt.escapeChars,
+				EscapeMode: escapeMode(tt.escapeChars),
 			}).Build()
 
 			bytes, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".golden"))
@@ -213,7 +257,7 @@ func TestSanitizeItemForAsciidocTable(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				EscapeCharacters: tt.escapeChars,
+				EscapeMode: escapeMode(tt.escapeChars),
 			}).Build()
 
 			bytes, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".golden"))
@@ -450,7 +494,7 @@ func TestEscapeIllegalCharacters(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				EscapeCharacters: tt.escapeChars,
+				EscapeMode: escapeMode(tt.escapeChars),
 			}).Build()
 			settings.EscapePipe = tt.escapePipe
 			actual := escapeIllegalCharacters(tt.input, settings)
@@ -460,6 +504,103 @@ func TestEscapeIllegalCharacters(t *testing.T) {
 	}
 }
 
+func TestEscapeIllegalCharactersHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		mode     string
+		expected string
+	}{
+		{
+			name:     "escape html in all mode",
+			input:    "lorem <b>ipsum</b> dolor `<sit>`",
+			mode:     "all",
+			expected: "lorem &lt;b&gt;ipsum&lt;/b&gt; dolor `<sit>`",
+		},
+		{
+			name:     "do not escape html in markdown mode",
+			input:    "lorem <b>ipsum</b> dolor `<sit>`",
+			mode:     "markdown",
+			expected: "lorem <b>ipsum</b> dolor `<sit>`",
+		},
+		{
+			name:     "do not escape html in none mode",
+			input:    "lorem <b>ipsum</b> dolor `<sit>`",
+			mode:     "none",
+			expected: "lorem <b>ipsum</b> dolor `<sit>`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().With(&print.Settings{
+				EscapeMode: tt.mode,
+			}).Build()
+			actual := escapeIllegalCharacters(tt.input, settings)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestEscapeAsciidocCharacters(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		escapePipe  bool
+		escapeChars bool
+		expected    string
+	}{
+		{
+			name:        "escape pipe",
+			input:       "lorem | ipsum |dolor| `adipi | scing`",
+			escapePipe:  true,
+			escapeChars: false,
+			expected:    "lorem \\| ipsum \\|dolor\\| `adipi \\| scing`",
+		},
+		{
+			name:        "do not escape pipe",
+			input:       "lorem | ipsum |dolor| `adipi | scing`",
+			escapePipe:  false,
+			escapeChars: false,
+			expected:    "lorem | ipsum |dolor| `adipi | scing`",
+		},
+		{
+			name:        "escape attribute reference",
+			input:       "lorem {ipsum} dolor `${foo_bar}` {} ${var.foo}",
+			escapePipe:  false,
+			escapeChars: true,
+			expected:    "lorem \\{ipsum} dolor `$\\{foo_bar}` {} ${var.foo}",
+		},
+		{
+			name:        "do not escape attribute reference",
+			input:       "lorem {ipsum} dolor `${foo_bar}` {} ${var.foo}",
+			escapePipe:  false,
+			escapeChars: false,
+			expected:    "lorem {ipsum} dolor `${foo_bar}` {} ${var.foo}",
+		},
+		{
+			name:        "do not escape underscore and asterisk",
+			input:       "lorem_ipsum *dolor* consectetur",
+			escapePipe:  true,
+			escapeChars: true,
+			expected:    "lorem_ipsum *dolor* consectetur",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().With(&print.Settings{
+				EscapeMode: escapeMode(tt.escapeChars),
+			}).Build()
+			settings.EscapePipe = tt.escapePipe
+			actual := escapeAsciidocCharacters(tt.input, settings)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestNormalizeURLs(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -508,7 +649,7 @@ func TestNormalizeURLs(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				EscapeCharacters: tt.escape,
+				EscapeMode: escapeMode(tt.escape),
 			}).Build()
 			actual := normalizeURLs(tt.input, settings)
 
@@ -558,7 +699,7 @@ func TestGenerateIndentation(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := testutil.Settings().With(&print.Settings{
-				IndentLevel: tt.base,
+				HeadingBaseLevel: tt.base,
 			}).Build()
 			actual := generateIndentation(tt.extra, "#", settings)
 
@@ -566,3 +707,61 @@ func TestGenerateIndentation(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateIndentationHeadingBaseLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		heading  int
+		base     int
+		extra    int
+		expected string
+	}{
+		{
+			name:     "generate indentation",
+			heading:  4,
+			base:     2,
+			extra:    0,
+			expected: "####",
+		},
+		{
+			name:     "generate indentation",
+			heading:  1,
+			base:     3,
+			extra:    1,
+			expected: "##",
+		},
+		{
+			name:     "generate indentation",
+			heading:  0,
+			base:     3,
+			extra:    0,
+			expected: "##",
+		},
+		{
+			name:     "generate indentation",
+			heading:  6,
+			base:     3,
+			extra:    0,
+			expected: "##",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().With(&print.Settings{
+				HeadingBaseLevel: tt.heading,
+				IndentLevel:      tt.base,
+			}).Build()
+			actual := generateIndentation(tt.extra, "#", settings)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func escapeMode(escape bool) string {
+	if escape {
+		return "markdown"
+	}
+	return "none"
+}
@@ -16,6 +16,10 @@ import (
 type Item struct {
 	Name string
 	Text string
+
+	// Raw indicates the Text is used as is and is not normalized
+	// (e.g. user-provided templates which are whitespace sensitive)
+	Raw bool
 }
 
 // Template represents a new Template with given name and content
@@ -56,24 +60,20 @@ func (t *Template) Settings(settings *print.Settings) {
 	}
 }
 
+// Validate checks all the items of the Template can be parsed
+func (t *Template) Validate() error {
+	_, err := t.parse()
+	return err
+}
+
 // Render renders the Template with given Module struct
 func (t *Template) Render(module *tfconf.Module) (string, error) {
-	if len(t.Items) < 1 {
-		return "", fmt.Errorf("base template not found")
+	tmpl, err := t.parse()
+	if err != nil {
+		return "", err
 	}
 	var buffer bytes.Buffer
-	tmpl := template.New(t.Items[0].Name)
-	tmpl.Funcs(t.funcMap)
-	template.Must(tmpl.Parse(normalize(t.Items[0].Text)))
-	for i, item := range t.Items {
-		if i == 0 {
-			continue
-		}
-		tt := tmpl.New(item.Name)
-		tt.Funcs(t.funcMap)
-		template.Must(tt.Parse(normalize(item.Text)))
-	}
-	err := tmpl.ExecuteTemplate(&buffer, t.Items[0].Name, struct {
+	err = tmpl.ExecuteTemplate(&buffer, t.Items[0].Name, struct {
 		Module   *tfconf.Module
 		Settings *print.Settings
 	}{
@@ -86,6 +86,39 @@ func (t *Template) Render(module *tfconf.Module) (string, error) {
 	return buffer.String(), nil
 }
 
+func (t *Template) parse() (*template.Template, error) {
+	if len(t.Items) < 1 {
+		return nil, fmt.Errorf("base template not found")
+	}
+	var tmpl *template.Template
+	include := template.FuncMap{
+		"include": func(name string, data interface{}) (string, error) {
+			var buffer bytes.Buffer
+			err := tmpl.ExecuteTemplate(&buffer, name, data)
+			return buffer.String(), err
+		},
+	}
+	for _, item := range t.Items {
+		var tt *template.Template
+		if tmpl == nil {
+			tmpl = template.New(item.Name)
+			tt = tmpl
+		} else {
+			tt = tmpl.New(item.Name)
+		}
+		text := item.Text
+		if !item.Raw {
+			text = normalize(text)
+		}
+		tt.Funcs(t.funcMap)
+		tt.Funcs(include)
+		if _, err := tt.Parse(text); err != nil {
+			return nil, err
+		}
+	}
+	return tmpl, nil
+}
+
 func builtinFuncs(settings *print.Settings) template.FuncMap {
 	return template.FuncMap{
 		"default": func(d string, s string) string {
@@ -109,6 +142,21 @@ func builtinFuncs(settings *print.Settings) template.FuncMap {
 			}
 			return falseValue
 		},
+		"sections": func() []string {
+			return settings.OrderedSections()
+		},
+		"noDefault": func(i *tfconf.Input) string {
+			if !settings.MarkMissingDefaults {
+				return ""
+			}
+			if marker := i.GetDefaultMarker(settings.ShowRequired); marker != "n/a" {
+				return marker
+			}
+			return settings.Placeholder
+		},
+		"placeholder": func() string {
+			return settings.Placeholder
+		},
 		"tostring": func(s types.String) string {
 			return string(s)
 		},
@@ -145,18 +193,40 @@ func builtinFuncs(settings *print.Settings) template.FuncMap {
 		"indent": func(l int, char string) string {
 			return generateIndentation(l, char, settings)
 		},
+		"title": func(section string, title string) string {
+			if t := settings.SectionTitles[section]; t != "" {
+				return t
+			}
+			return title
+		},
 		"name": func(n string) string {
 			return sanitizeName(n, settings)
 		},
 		"sanitizeHeader": func(s string) string {
-			settings.EscapePipe = false
-			s = sanitizeItemForDocument(s, settings)
-			settings.EscapePipe = true
-			return s
+			// header and footer are written by module authors and can
+			// contain intentional HTML (e.g. badges) in any escape mode
+			header := *settings
+			header.EscapePipe = false
+			if header.EscapeHTML() {
+				header.EscapeMode = "markdown"
+			}
+			return sanitizeItemForDocument(s, &header)
+		},
+		"sanitizeAsciidocHeader": func(s string) string {
+			header := *settings
+			header.EscapeMode = "none"
+			header.EscapePipe = false
+			return sanitizeItemForDocument(s, &header)
 		},
 		"sanitizeDoc": func(s string) string {
 			return sanitizeItemForDocument(s, settings)
 		},
+		"sanitizeDescription": func(s string) string {
+			if settings.RawDescriptions {
+				return sanitizeRawItemForDocument(s, settings)
+			}
+			return sanitizeItemForDocument(s, settings)
+		},
 		"sanitizeTbl": func(s string) string {
 			settings.EscapePipe = true
 			s = sanitizeItemForTable(s, settings)
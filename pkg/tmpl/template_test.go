@@ -49,12 +49,35 @@ func TestTemplateRender(t *testing.T) {
 			expected: "customized <<sample header>>",
 			wantErr:  false,
 		},
+		{
+			name: "template render raw item",
+			items: []*Item{
+				{
+					Name: "all",
+					Text: "  - {{ .Module.Header }}\n    - {{ custom .Module.Header }}",
+					Raw:  true,
+				},
+			},
+			expected: "  - sample header\n    - customized <<sample header>>",
+			wantErr:  false,
+		},
 		{
 			name:     "template render with custom functions",
 			items:    []*Item{},
 			expected: "",
 			wantErr:  true,
 		},
+		{
+			name: "template render invalid template",
+			items: []*Item{
+				{
+					Name: "all",
+					Text: `{{- if .Module.Header }}`,
+				},
+			},
+			expected: "",
+			wantErr:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -478,7 +501,7 @@ func TestBuiltinFunc(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			settings := print.NewSettings()
-			settings.EscapeCharacters = tt.escapeChar
+			settings.EscapeMode = escapeMode(tt.escapeChar)
 			settings.EscapePipe = tt.escapePipe
 			funcs := builtinFuncs(settings)
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
@@ -133,6 +134,8 @@ func getFlags(name string) string {
 	switch strings.Replace(name, "terraform-docs ", "", -1) {
 	case "pretty":
 		return " --no-color"
+	case "template":
+		return " --output-template ./examples/template.tpl"
 	}
 	return ""
 }
@@ -147,10 +150,19 @@ func printExample(buf *bytes.Buffer, name string) error {
 
 	settings := print.NewSettings()
 	settings.ShowColor = false
+	if strings.HasSuffix(name, " template") {
+		content, err := ioutil.ReadFile("./examples/template.tpl")
+		if err != nil {
+			return err
+		}
+		settings.Template = string(content)
+	}
 	options := &module.Options{
-		Path:           "./examples",
-		ShowHeader:     true,
-		HeaderFromFile: "main.tf",
+		Path:              "./examples",
+		ShowHeader:        true,
+		HeaderFromFiles:   []string{"main.tf"},
+		ReadComments:      true,
+		ShowTerraformCore: true,
 		SortBy: &module.SortBy{
 			Name:     settings.SortByName,
 			Required: settings.SortByRequired,